@@ -0,0 +1,72 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gopher-co/td-game/io"
+	"github.com/gopher-co/td-game/models/config"
+)
+
+// TestShippedData checks that the configs of the game are valid
+// and every mechanic of the towers and the enemies is used by them.
+func TestShippedData(t *testing.T) {
+	towers, err := io.ReadConfigs[config.Tower]("./Towers", ".twr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	enemies, err := io.ReadConfigs[config.Enemy]("./Enemies", ".enm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	levels, err := io.ReadConfigs[config.Level]("./Levels", ".lvl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kinds := map[config.TowerKind]bool{}
+	for i := range towers {
+		tw := &towers[i]
+		if err := tw.Valid(); err != nil {
+			t.Error(err)
+		}
+		if _, err := os.Stat("./assets/" + tw.Name + ".png"); err != nil {
+			t.Errorf("tower %v: %v", tw.Name, err)
+		}
+		kinds[tw.TowerKind()] = true
+	}
+	for _, k := range []config.TowerKind{config.KindShooter, config.KindAura, config.KindIncome, config.KindDetector} {
+		if !kinds[k] {
+			t.Errorf("no tower of kind %v", k)
+		}
+	}
+
+	byName := map[string]*config.Enemy{}
+	for i := range enemies {
+		byName[enemies[i].Name] = &enemies[i]
+	}
+
+	hidden := false
+	for _, e := range byName {
+		if err := e.Valid(byName); err != nil {
+			t.Error(err)
+		}
+		if _, err := os.Stat("./assets/" + e.Name + ".png"); err != nil {
+			t.Errorf("enemy %v: %v", e.Name, err)
+		}
+		hidden = hidden || e.Hidden
+	}
+	if !hidden {
+		t.Error("no hidden enemy")
+	}
+
+	for _, l := range levels {
+		for _, w := range l.GameRule {
+			for _, s := range w.Swarms {
+				if _, ok := byName[s.EnemyName]; !ok {
+					t.Errorf("level %v: enemy %v doesn't exist", l.LevelName, s.EnemyName)
+				}
+			}
+		}
+	}
+}
@@ -4,10 +4,19 @@ import (
 	"net"
 
 	"github.com/gopher-co/td-game/models/coopstate"
+	"github.com/gopher-co/td-game/models/gamecontext"
 )
 
 func main() {
-	s := coopstate.NewServer("1. Tutorial", 1)
+	ctx, err := gamecontext.Load()
+	if err != nil {
+		panic(err)
+	}
+
+	s, _, err := coopstate.NewServer(ctx, "1. Tutorial", 1)
+	if err != nil {
+		panic(err)
+	}
 	l, _ := net.Listen("tcp", ":8080")
 
 	if err := s.Serve(l); err != nil {
@@ -0,0 +1,63 @@
+package io
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+// LoadAchievementConfigs loads achievement configs from the Achievements directory.
+func LoadAchievementConfigs() ([]config.Achievement, error) {
+	acfgs, err := ReadConfigs[config.Achievement]("./Achievements", ".ach")
+	if err != nil {
+		return nil, err
+	}
+
+	return acfgs, nil
+}
+
+// LoadAchievements loads the progress of the achievements from the achievements.json file.
+func LoadAchievements() (*ingame.Achievements, error) {
+	f, err := os.Open("achievements.json")
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return &ingame.Achievements{Unlocked: map[string]struct{}{}}, nil
+		}
+
+		return nil, fmt.Errorf("achievements file can't be open: %w", err)
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	a := new(ingame.Achievements)
+	if err = json.NewDecoder(f).Decode(a); err != nil {
+		return nil, fmt.Errorf("achievements json not parsed: %w", err)
+	}
+
+	return a, nil
+}
+
+// SaveAchievements saves the progress of the achievements to the achievements.json file.
+func SaveAchievements(a *ingame.Achievements) error {
+	f, err := os.OpenFile("achievements.json", os.O_WRONLY|os.O_SYNC|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return fmt.Errorf("achievements file can't be open: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	buf := bufio.NewWriter(f)
+	if err := json.NewEncoder(buf).Encode(*a); err != nil {
+		return fmt.Errorf("unsuccessful save: %w", err)
+	}
+
+	return buf.Flush()
+}
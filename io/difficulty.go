@@ -0,0 +1,15 @@
+package io
+
+import (
+	"github.com/gopher-co/td-game/models/config"
+)
+
+// LoadDifficultyConfigs loads difficulty configs from the Difficulties directory.
+func LoadDifficultyConfigs() ([]config.Difficulty, error) {
+	dcfgs, err := ReadConfigs[config.Difficulty]("./Difficulties", ".dif")
+	if err != nil {
+		return nil, err
+	}
+
+	return dcfgs, nil
+}
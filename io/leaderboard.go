@@ -0,0 +1,52 @@
+package io
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+// LoadLeaderboard loads the leaderboard from the leaderboard.json file.
+func LoadLeaderboard() (ingame.Leaderboard, error) {
+	f, err := os.Open("leaderboard.json")
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return ingame.Leaderboard{}, nil
+		}
+
+		return nil, fmt.Errorf("leaderboard file can't be open: %w", err)
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	lb := ingame.Leaderboard{}
+	if err = json.NewDecoder(f).Decode(&lb); err != nil {
+		return nil, fmt.Errorf("leaderboard json not parsed: %w", err)
+	}
+
+	return lb, nil
+}
+
+// SaveLeaderboard saves the leaderboard to the leaderboard.json file.
+func SaveLeaderboard(lb ingame.Leaderboard) error {
+	f, err := os.OpenFile("leaderboard.json", os.O_WRONLY|os.O_SYNC|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return fmt.Errorf("leaderboard file can't be open: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	buf := bufio.NewWriter(f)
+	if err := json.NewEncoder(buf).Encode(lb); err != nil {
+		return fmt.Errorf("unsuccessful save: %w", err)
+	}
+
+	return buf.Flush()
+}
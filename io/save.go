@@ -0,0 +1,62 @@
+package io
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/gopher-co/td-game/models/controller"
+)
+
+// LoadSave loads the unfinished game from the save.json file.
+// Returns nil if there is no saved game.
+func LoadSave() (*controller.Save, error) {
+	f, err := os.Open("save.json")
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("save file can't be open: %w", err)
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	s := new(controller.Save)
+	if err = json.NewDecoder(f).Decode(s); err != nil {
+		return nil, fmt.Errorf("save json not parsed: %w", err)
+	}
+
+	return s, nil
+}
+
+// SaveGame saves the unfinished game to the save.json file.
+func SaveGame(s *controller.Save) error {
+	f, err := os.OpenFile("save.json", os.O_WRONLY|os.O_SYNC|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return fmt.Errorf("save file can't be open: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	buf := bufio.NewWriter(f)
+	if err := json.NewEncoder(buf).Encode(s); err != nil {
+		return fmt.Errorf("unsuccessful save: %w", err)
+	}
+
+	return buf.Flush()
+}
+
+// RemoveSave removes the save.json file of the finished game.
+func RemoveSave() error {
+	if err := os.Remove("save.json"); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("save file can't be removed: %w", err)
+	}
+
+	return nil
+}
@@ -20,6 +20,14 @@ func LoadTowerConfigs() ([]config.Tower, error) {
 		if err := tcfgs[k].ProjectileConfig.InitImage(); err != nil {
 			return nil, fmt.Errorf("projectile image init failed: %w", err)
 		}
+		for _, u := range tcfgs[k].Upgrades {
+			if u.ProjectileConfig == nil {
+				continue
+			}
+			if err := u.ProjectileConfig.InitImage(); err != nil {
+				return nil, fmt.Errorf("upgrade projectile image init failed: %w", err)
+			}
+		}
 	}
 
 	return tcfgs, nil
@@ -1,7 +1,11 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"slices"
+	"strconv"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
@@ -23,6 +27,16 @@ const (
 
 	// PathWidth is a width of the path.
 	PathWidth = 64
+
+	// MapWidth is a width of the map.
+	MapWidth = 1500
+
+	// MapHeight is a height of the map.
+	MapHeight = 1080
+
+	// DefaultPathName is a name of the path described by the Map.Path field.
+	// It is also used by the swarms that don't specify the path.
+	DefaultPathName = "main"
 )
 
 // Config structures are need to pass then to NewXXX functions.
@@ -44,15 +58,49 @@ type Enemy struct {
 	// MoneyAward is a money award for killing the enemy.
 	MoneyAward int `json:"money_award"`
 
+	// Evasion is a percent of the projectiles the enemy evades.
+	Evasion int `json:"evasion"`
+
+	// Hidden is a flag that shows if the enemy can be attacked
+	// only when it is revealed by a detector tower.
+	Hidden bool `json:"hidden"`
+
 	// Strengths is a list of strengths of the enemy.
 	Strengths []Strength `json:"strengths"`
 
 	// Weaknesses is a list of weaknesses of the enemy.
 	Weaknesses []Weakness `json:"weaknesses"`
 
+	// Boss is a flag that shows if the enemy is a boss.
+	// The health of the boss is shown on the top of the map.
+	Boss bool `json:"boss"`
+
+	// Phases is a list of the phases of the enemy in the order of triggering.
+	Phases []Phase `json:"phases"`
+
 	image *ebiten.Image
 }
 
+// Phase is a config for the phase of the enemy.
+// The phase is triggered when the health of the enemy falls to HealthPercent of the maximal one.
+type Phase struct {
+	// HealthPercent is a percent of the maximal health that triggers the phase.
+	HealthPercent int `json:"health_percent"`
+
+	// SpeedMultiplier multiplies the speed of the enemy.
+	// If it's zero, the speed isn't changed.
+	SpeedMultiplier float64 `json:"speed_multiplier"`
+
+	// Strengths replaces the strengths of the enemy if it is set.
+	Strengths []Strength `json:"strengths"`
+
+	// Adds is a list of the names of the enemies spawned at the enemy's position.
+	Adds []string `json:"adds"`
+
+	// Immune is a time the enemy takes no damage after the phase is triggered.
+	Immune general.Frames `json:"immune"`
+}
+
 // Strength is a config for strength.
 type Strength struct {
 	// T is a type of the strength.
@@ -100,11 +148,69 @@ func (c *Enemy) Image() *ebiten.Image {
 	return c.image
 }
 
+// Valid returns an error if the evasion of the enemy isn't a percent,
+// the phases of the enemy aren't in the order of triggering
+// or spawn the enemies that don't exist.
+func (c *Enemy) Valid(enemies map[string]*Enemy) error {
+	if c.Evasion < 0 || c.Evasion >= 100 {
+		return fmt.Errorf("enemy %v: evasion must be between 0 and 99", c.Name)
+	}
+
+	for i, p := range c.Phases {
+		if p.HealthPercent <= 0 || p.HealthPercent >= 100 {
+			return fmt.Errorf("enemy %v: phase %d: health percent must be between 0 and 100", c.Name, i+1)
+		}
+		if i > 0 && p.HealthPercent >= c.Phases[i-1].HealthPercent {
+			return fmt.Errorf("enemy %v: phase %d is triggered before the previous one", c.Name, i+1)
+		}
+
+		for _, a := range p.Adds {
+			if _, ok := enemies[a]; !ok {
+				return fmt.Errorf("enemy %v: phase %d: enemy %v doesn't exist", c.Name, i+1, a)
+			}
+		}
+	}
+
+	return nil
+}
+
+// TowerKind is a kind of the tower.
+type TowerKind string
+
+const (
+	// KindShooter is a kind of the tower that shoots projectiles at the enemies.
+	KindShooter TowerKind = "shooter"
+
+	// KindAura is a kind of the tower that boosts the towers in its radius.
+	KindAura TowerKind = "aura"
+
+	// KindIncome is a kind of the tower that brings money after each wave.
+	KindIncome TowerKind = "income"
+
+	// KindDetector is a kind of the tower that reveals hidden enemies in its radius.
+	KindDetector TowerKind = "detector"
+)
+
 // Tower is a config for tower.
 type Tower struct {
 	// Name is a name of the tower.
 	Name string `json:"name"`
 
+	// Kind is a kind of the tower. Empty Kind means KindShooter.
+	Kind TowerKind `json:"kind"`
+
+	// Aura is an effect of the aura tower on the towers in its radius.
+	Aura *Aura `json:"aura"`
+
+	// Income is a money the income tower brings after each wave.
+	Income int `json:"income"`
+
+	// Ability is an active ability of the tower.
+	Ability *Ability `json:"ability"`
+
+	// Ranks is a list of the veterancy ranks of the tower in the order of gaining.
+	Ranks []Rank `json:"ranks"`
+
 	// Upgrades is a list of upgrades of the tower.
 	Upgrades []Upgrade `json:"upgrades"`
 
@@ -126,6 +232,12 @@ type Tower struct {
 	// InitProjectileVrms is an initial projectile vrms of the tower.
 	InitProjectileVrms general.Coord `json:"init_projectile_speed"`
 
+	// CritChance is a percent of the projectiles of the tower that deal the critical damage.
+	CritChance int `json:"crit_chance"`
+
+	// CritDamage is a critical damage of the tower in percent of its damage.
+	CritDamage int `json:"crit_damage"`
+
 	// ProjectileConfig is a config for projectile.
 	ProjectileConfig Projectile `json:"projectile_config"`
 
@@ -164,8 +276,109 @@ func (c *Tower) Image() *ebiten.Image {
 	return c.image
 }
 
+// UpgradeTree returns the upgrades of the tower with their IDs and parents set.
+//
+// If none of the upgrades has ID or Parent, the upgrades form a linear chain
+// in the order of the list. Otherwise, the upgrades without Parent are the roots of the tree.
+// The upgrades without ID get their index in the list as ID.
+func (c *Tower) UpgradeTree() []Upgrade {
+	linear := true
+	for _, u := range c.Upgrades {
+		if u.ID != "" || u.Parent != "" {
+			linear = false
+			break
+		}
+	}
+
+	ups := make([]Upgrade, len(c.Upgrades))
+	for i, u := range c.Upgrades {
+		if u.ID == "" {
+			u.ID = strconv.Itoa(i)
+		}
+		if linear && i > 0 {
+			u.Parent = ups[i-1].ID
+		}
+
+		ups[i] = u
+	}
+
+	return ups
+}
+
+// TowerKind returns the kind of the tower.
+func (c *Tower) TowerKind() TowerKind {
+	if c.Kind == "" {
+		return KindShooter
+	}
+
+	return c.Kind
+}
+
+// Valid returns an error if the kind of the tower is unknown,
+// its critical hits are wrong or the upgrade tree of the tower is broken.
+func (c *Tower) Valid() error {
+	switch c.TowerKind() {
+	case KindShooter, KindIncome, KindDetector:
+	case KindAura:
+		if c.Aura == nil {
+			return fmt.Errorf("tower %v: aura tower without aura", c.Name)
+		}
+	default:
+		return fmt.Errorf("tower %v: unknown kind %v", c.Name, c.Kind)
+	}
+
+	if c.CritChance < 0 || c.CritChance > 100 {
+		return fmt.Errorf("tower %v: crit chance must be between 0 and 100", c.Name)
+	}
+	if c.CritChance > 0 && c.CritDamage < 100 {
+		return fmt.Errorf("tower %v: crit damage must be at least 100 percent", c.Name)
+	}
+
+	if c.Ability != nil && c.Ability.Kind != AbilityOverdrive {
+		return fmt.Errorf("tower %v: ability %v can't be used by towers", c.Name, c.Ability.Name)
+	}
+
+	for i := 1; i < len(c.Ranks); i++ {
+		prev, r := c.Ranks[i-1], c.Ranks[i]
+		if r.Kills < prev.Kills || r.Damage < prev.Damage {
+			return fmt.Errorf("tower %v: rank %v requires less than the previous rank", c.Name, r.Name)
+		}
+	}
+
+	ups := c.UpgradeTree()
+
+	ids := make(map[string]struct{}, len(ups))
+	for _, u := range ups {
+		if _, ok := ids[u.ID]; ok {
+			return fmt.Errorf("tower %v: duplicate upgrade id %v", c.Name, u.ID)
+		}
+		ids[u.ID] = struct{}{}
+	}
+
+	for _, u := range ups {
+		if _, ok := ids[u.Parent]; u.Parent != "" && !ok {
+			return fmt.Errorf("tower %v: parent %v of upgrade %v doesn't exist", c.Name, u.Parent, u.ID)
+		}
+	}
+
+	return nil
+}
+
 // Upgrade is a config for tower's upgrade.
+//
+// Upgrades form a tree: the player buys one of the children of the last bought upgrade,
+// so the sibling upgrades are mutually exclusive branches.
 type Upgrade struct {
+	// ID is an identifier of the upgrade unique within the tower.
+	ID string `json:"id"`
+
+	// Parent is an ID of the upgrade that must be bought before this one.
+	// Empty Parent means the upgrade may be bought first.
+	Parent string `json:"parent"`
+
+	// Name is a name of the upgrade shown to the player.
+	Name string `json:"name"`
+
 	// Price is a price of the upgrade.
 	Price int `json:"price"`
 
@@ -178,10 +391,99 @@ type Upgrade struct {
 	// DeltaRadius is a delta radius of the upgrade.
 	DeltaRadius general.Coord `json:"delta_radius"`
 
+	// DeltaProjectileVrms is a delta projectile vrms of the upgrade.
+	DeltaProjectileVrms general.Coord `json:"delta_projectile_speed"`
+
+	// DeltaIncome is a delta income of the upgrade.
+	DeltaIncome int `json:"delta_income"`
+
+	// Type replaces the type of the tower attack if it is set.
+	Type *general.TypeAttack `json:"type"`
+
+	// ProjectileConfig replaces the projectile of the tower if it is set.
+	ProjectileConfig *Projectile `json:"projectile_config"`
+
 	// OpenLevel is a level when the upgrade can be opened.
 	OpenLevel string `json:"open_level"`
 }
 
+// Rank is a config for the veterancy rank of the tower.
+// The tower gains the rank when it has both killed Kills enemies and dealt Damage damage.
+type Rank struct {
+	// Name is a name of the rank.
+	Name string `json:"name"`
+
+	// Kills is a number of the kills required for the rank.
+	Kills int `json:"kills"`
+
+	// Damage is a damage dealt required for the rank.
+	Damage int `json:"damage"`
+
+	// DeltaDamage is a delta damage of the rank.
+	DeltaDamage int `json:"delta_damage"`
+
+	// DeltaSpeedAttack is a delta speed attack of the rank.
+	DeltaSpeedAttack general.Frames `json:"delta_speed_attack"`
+
+	// DeltaRadius is a delta radius of the rank.
+	DeltaRadius general.Coord `json:"delta_radius"`
+}
+
+// Aura is a config for the effect of the aura tower.
+type Aura struct {
+	// DeltaDamage is a delta damage of the towers in the radius.
+	DeltaDamage int `json:"delta_damage"`
+
+	// DeltaRadius is a delta radius of the towers in the radius.
+	DeltaRadius general.Coord `json:"delta_radius"`
+
+	// DeltaSpeedAttack is a delta speed attack of the towers in the radius.
+	DeltaSpeedAttack general.Frames `json:"delta_speed_attack"`
+}
+
+// AbilityKind is a kind of the active ability.
+type AbilityKind string
+
+const (
+	// AbilityOverdrive is a kind of the tower's ability that boosts the tower for Duration.
+	AbilityOverdrive AbilityKind = "overdrive"
+
+	// AbilityAirstrike is a kind of the player's ability that damages the enemies
+	// in Radius around the chosen point after Duration.
+	AbilityAirstrike AbilityKind = "airstrike"
+)
+
+// Ability is a config for active ability.
+type Ability struct {
+	// Name is a name of the ability.
+	Name string `json:"name"`
+
+	// Kind is a kind of the ability.
+	Kind AbilityKind `json:"kind"`
+
+	// Cooldown is a time after the activation when the ability can't be used.
+	Cooldown general.Frames `json:"cooldown"`
+
+	// Duration is a time the overdrive lasts or the airstrike flies.
+	Duration general.Frames `json:"duration"`
+
+	// DeltaDamage is a delta damage of the overdrive.
+	DeltaDamage int `json:"delta_damage"`
+
+	// DeltaRadius is a delta radius of the overdrive.
+	DeltaRadius general.Coord `json:"delta_radius"`
+
+	// DeltaSpeedAttack is a delta speed attack of the overdrive.
+	// The speed attack is a number of the shots in 20 seconds, so the boost is positive.
+	DeltaSpeedAttack general.Frames `json:"delta_speed_attack"`
+
+	// Damage is a damage of the airstrike.
+	Damage int `json:"damage"`
+
+	// Radius is a radius of the airstrike.
+	Radius general.Coord `json:"radius"`
+}
+
 // Projectile is a config for projectile.
 type Projectile struct {
 	Name  string
@@ -218,10 +520,317 @@ type Level struct {
 	// GameRule is a config for game rule.
 	GameRule GameRule `json:"game_rule"`
 
+	// Abilities is a list of the player's abilities on the level.
+	Abilities []Ability `json:"abilities"`
+
+	// Rules are the rule modifiers of the level.
+	// If they are nil, DefaultRules are used.
+	Rules *Rules `json:"rules"`
+
+	// Stars is a list of the scores needed for one, two and three stars.
+	Stars []int `json:"stars"`
+
 	// needed for level numeration
 	Order int `json:"-"`
 }
 
+// Valid returns an error if the level refers to the map
+// or the paths that don't exist, or its abilities can't be used by the player.
+func (c *Level) Valid(maps map[string]*Map) error {
+	m, ok := maps[c.MapName]
+	if !ok {
+		return fmt.Errorf("map %v doesn't exist", c.MapName)
+	}
+
+	for _, a := range c.Abilities {
+		if a.Kind != AbilityAirstrike {
+			return fmt.Errorf("ability %v can't be used by the player", a.Name)
+		}
+	}
+
+	if len(c.Stars) > MaxStars || !slices.IsSorted(c.Stars) {
+		return fmt.Errorf("level %v: star scores must be ascending and at most %d", c.LevelName, MaxStars)
+	}
+
+	if r := c.LevelRules(); r.StartHealth <= 0 || r.StartMoney < 0 || r.SellRefund < 0 || r.MaxUpgrades < 0 || r.EnemyHealthMultiplier <= 0 {
+		return fmt.Errorf("level %v: invalid rules", c.LevelName)
+	}
+
+	paths := m.AllPaths()
+	for i, w := range c.GameRule {
+		for _, s := range w.Swarms {
+			name := s.PathName
+			if name == "" {
+				name = DefaultPathName
+			}
+
+			if len(paths[name]) < 2 {
+				return fmt.Errorf("wave %d: path %v doesn't exist on map %v", i+1, name, c.MapName)
+			}
+		}
+	}
+
+	return nil
+}
+
+// MaxStars is a maximal number of the stars for the level.
+const MaxStars = 3
+
+// StarsFor returns the number of the stars awarded for the score of the won game.
+// The won game gets at least one star.
+func (c *Level) StarsFor(score int) int {
+	stars := 1
+	for i, s := range c.Stars {
+		if score >= s {
+			stars = i + 1
+		}
+	}
+
+	return stars
+}
+
+// LevelRules returns the rule modifiers of the level.
+func (c *Level) LevelRules() Rules {
+	if c.Rules == nil {
+		return DefaultRules()
+	}
+
+	return *c.Rules
+}
+
+// Rules is a config for the rule modifiers of the level.
+// The fields missing in the config get the values of DefaultRules.
+type Rules struct {
+	// StartHealth is a health of the player at the start of the level.
+	StartHealth int `json:"start_health"`
+
+	// StartMoney is a money of the player at the start of the level.
+	StartMoney int `json:"start_money"`
+
+	// SellRefund is a percent of the money spent on the tower returned when it is sold.
+	SellRefund int `json:"sell_refund"`
+
+	// Towers is a whitelist of the towers that can be bought.
+	// If it's empty, all the towers can be bought.
+	Towers []string `json:"towers"`
+
+	// BannedTowers is a blacklist of the towers that can't be bought.
+	BannedTowers []string `json:"banned_towers"`
+
+	// MaxUpgrades is a maximal number of the upgrades of each tower.
+	// If it's zero, the upgrades aren't limited.
+	MaxUpgrades int `json:"max_upgrades"`
+
+	// WaveBonus is a money given to the player after each wave.
+	WaveBonus int `json:"wave_bonus"`
+
+	// Interest is a percent of the unspent money given to the player after each wave.
+	Interest int `json:"interest"`
+
+	// EnemyHealthMultiplier multiplies the maximal health of all the enemies.
+	EnemyHealthMultiplier float64 `json:"enemy_health_multiplier"`
+}
+
+// DefaultRules returns the rules of the level that has no rule modifiers.
+func DefaultRules() Rules {
+	return Rules{
+		StartHealth:           100,
+		StartMoney:            650,
+		SellRefund:            70,
+		EnemyHealthMultiplier: 1,
+	}
+}
+
+// UnmarshalJSON unmarshals the rules filling the missing fields with DefaultRules.
+func (r *Rules) UnmarshalJSON(b []byte) error {
+	type rules Rules
+
+	v := rules(DefaultRules())
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*r = Rules(v)
+
+	return nil
+}
+
+// TowerAllowed checks if the tower with the name can be bought on the level.
+func (r *Rules) TowerAllowed(name string) bool {
+	if len(r.Towers) > 0 && !slices.Contains(r.Towers, name) {
+		return false
+	}
+
+	return !slices.Contains(r.BannedTowers, name)
+}
+
+// Refund returns the money returned for the tower the price was paid for.
+func (r *Rules) Refund(price int) int {
+	return price * r.SellRefund / 100
+}
+
+// WaveReward returns the bonus and the interest on the money given after the wave.
+func (r *Rules) WaveReward(money int) int {
+	return r.WaveBonus + money*r.Interest/100
+}
+
+// DefaultDifficultyName is a name of the difficulty chosen when no other is.
+const DefaultDifficultyName = "Normal"
+
+// Difficulty is a config for difficulty.
+// It multiplies the stats of the enemies, the starting money and the prices of the towers.
+type Difficulty struct {
+	// Name is a name of the difficulty.
+	Name string `json:"name"`
+
+	// EnemyHealth multiplies the maximal health of the enemies.
+	EnemyHealth float64 `json:"enemy_health"`
+
+	// EnemySpeed multiplies the speed of the enemies.
+	EnemySpeed float64 `json:"enemy_speed"`
+
+	// EnemyMoneyAward multiplies the money award for killing the enemies.
+	EnemyMoneyAward float64 `json:"enemy_money_award"`
+
+	// StartMoney multiplies the money of the player at the start of the level.
+	StartMoney float64 `json:"start_money"`
+
+	// TowerPrice multiplies the prices of the towers and their upgrades.
+	TowerPrice float64 `json:"tower_price"`
+
+	// needed for difficulty numeration
+	Order int `json:"-"`
+}
+
+// DefaultDifficulty returns the difficulty that changes nothing.
+func DefaultDifficulty() *Difficulty {
+	return &Difficulty{
+		Name:            DefaultDifficultyName,
+		EnemyHealth:     1,
+		EnemySpeed:      1,
+		EnemyMoneyAward: 1,
+		StartMoney:      1,
+		TowerPrice:      1,
+	}
+}
+
+// Valid returns an error if the health or the speed multiplier of the difficulty isn't positive
+// or any other multiplier is negative.
+func (c *Difficulty) Valid() error {
+	if c.EnemyHealth <= 0 || c.EnemySpeed <= 0 {
+		return fmt.Errorf("difficulty %v: enemy health and speed multipliers must be positive", c.Name)
+	}
+	if c.EnemyMoneyAward < 0 || c.StartMoney < 0 || c.TowerPrice < 0 {
+		return fmt.Errorf("difficulty %v: multipliers must not be negative", c.Name)
+	}
+
+	return nil
+}
+
+// Enemies returns the copies of the enemies with the stats multiplied.
+func (c *Difficulty) Enemies(enemies map[string]*Enemy) map[string]*Enemy {
+	es := make(map[string]*Enemy, len(enemies))
+	for k, v := range enemies {
+		e := *v
+		e.MaxHealth = max(1, scale(e.MaxHealth, c.EnemyHealth))
+		e.Vrms *= general.Coord(c.EnemySpeed)
+		e.MoneyAward = scale(e.MoneyAward, c.EnemyMoneyAward)
+		es[k] = &e
+	}
+
+	return es
+}
+
+// Towers returns the copies of the towers with the prices multiplied.
+func (c *Difficulty) Towers(towers map[string]*Tower) map[string]*Tower {
+	ts := make(map[string]*Tower, len(towers))
+	for k, v := range towers {
+		t := *v
+		t.Price = scale(t.Price, c.TowerPrice)
+		t.Upgrades = slices.Clone(t.Upgrades)
+		for i := range t.Upgrades {
+			t.Upgrades[i].Price = scale(t.Upgrades[i].Price, c.TowerPrice)
+		}
+		ts[k] = &t
+	}
+
+	return ts
+}
+
+// Money returns the starting money multiplied.
+func (c *Difficulty) Money(money int) int {
+	return scale(money, c.StartMoney)
+}
+
+// scale multiplies v by m and rounds the result.
+func scale(v int, m float64) int {
+	return int(math.Round(float64(v) * m))
+}
+
+// AchievementKind is a kind of the condition of the achievement.
+type AchievementKind string
+
+const (
+	// AchievementKills is a kind of the achievement that is unlocked
+	// when the player has killed Count enemies in all the games.
+	AchievementKills AchievementKind = "kills"
+
+	// AchievementFlawless is a kind of the achievement that is unlocked
+	// when the player wins without losing health.
+	AchievementFlawless AchievementKind = "flawless"
+
+	// AchievementOnlyTowers is a kind of the achievement that is unlocked
+	// when the player wins having built only the Towers.
+	AchievementOnlyTowers AchievementKind = "only_towers"
+)
+
+// Achievement is a config for achievement.
+type Achievement struct {
+	// Name is a name of the achievement.
+	Name string `json:"name"`
+
+	// Description is a description of the achievement shown in the menu.
+	Description string `json:"description"`
+
+	// Kind is a kind of the condition of the achievement.
+	Kind AchievementKind `json:"kind"`
+
+	// Count is a number of the kills needed.
+	Count int `json:"count,omitempty"`
+
+	// Towers is a list of the names of the towers allowed.
+	Towers []string `json:"towers,omitempty"`
+
+	// Level is a name of the level that must be won.
+	// If it's empty, any level counts. The kills achievements ignore it.
+	Level string `json:"level,omitempty"`
+}
+
+// Valid returns an error if the achievement has an unknown kind
+// or refers to the level or the towers that don't exist.
+func (c *Achievement) Valid(levels map[string]*Level, towers map[string]*Tower) error {
+	switch c.Kind {
+	case AchievementKills:
+		if c.Count <= 0 {
+			return fmt.Errorf("achievement %v: count must be positive", c.Name)
+		}
+	case AchievementFlawless:
+	case AchievementOnlyTowers:
+		for _, t := range c.Towers {
+			if _, ok := towers[t]; !ok {
+				return fmt.Errorf("achievement %v: tower %v doesn't exist", c.Name, t)
+			}
+		}
+	default:
+		return fmt.Errorf("achievement %v: unknown kind %q", c.Name, c.Kind)
+	}
+
+	if _, ok := levels[c.Level]; c.Level != "" && !ok {
+		return fmt.Errorf("achievement %v: level %v doesn't exist", c.Name, c.Level)
+	}
+
+	return nil
+}
+
 // GameRule is a config for game rule.
 type GameRule []Wave
 
@@ -243,6 +852,14 @@ type EnemySwarm struct {
 
 	// MaxCalls is a maximal amount of enemies that can be called.
 	MaxCalls int `json:"max_calls"`
+
+	// PathName is a name of the path the enemies go along.
+	// If it's empty, DefaultPathName is used.
+	PathName string `json:"path_name"`
+
+	// HealthMultiplier multiplies the maximal health of the enemies.
+	// If it's zero, the health isn't changed.
+	HealthMultiplier float64 `json:"health_multiplier"`
 }
 
 // UI is a config for GlobalUI.
@@ -262,9 +879,35 @@ type Map struct {
 	// Path is a path of the map.
 	Path []general.Point `json:"path"`
 
+	// Paths is a set of the named paths of the map.
+	// Paths may fork, merge or have different spawn points.
+	Paths map[string][]general.Point `json:"paths"`
+
+	// Buildable is a list of zones where towers can be built.
+	// If it is empty, towers can be built anywhere on the map.
+	Buildable []general.Polygon `json:"buildable"`
+
+	// Unbuildable is a list of zones where towers can't be built.
+	Unbuildable []general.Polygon `json:"unbuildable"`
+
 	image *ebiten.Image
 }
 
+// AllPaths returns all the paths of the map by their names.
+// Path is returned with the DefaultPathName name.
+func (c *Map) AllPaths() map[string][]general.Point {
+	paths := make(map[string][]general.Point, len(c.Paths)+1)
+	for k, v := range c.Paths {
+		paths[k] = v
+	}
+
+	if len(c.Path) != 0 {
+		paths[DefaultPathName] = c.Path
+	}
+
+	return paths
+}
+
 // InitImage initializes image from the temporary state of the entity.
 func (c *Map) InitImage() error {
 	png, err := ui.InitPNG("./assets/" + c.Name)
@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gopher-co/td-game/models/general"
+)
+
+// TPS is a number of the frames per second.
+// The game simulates one tick per frame at the normal speed.
+const TPS = 60
+
+// Scales are the time scales of the game: the numbers of the ticks simulated per frame.
+var Scales = []int{1, 2, 4, 8}
+
+// Clock decides how many ticks the game is advanced by on each frame.
+//
+// The frames come TPS times a second whatever the speed of the game is.
+// The game is sped up by simulating more ticks per frame, so a tick is
+// the same on every machine and the replays and the co-op games don't
+// depend on the frame rate.
+type Clock struct {
+	// Scale is a number of the ticks simulated per frame.
+	Scale int
+
+	// Paused is a flag that shows if the game is paused.
+	// The paused game is advanced only by the steps.
+	Paused bool
+
+	// step is a flag that shows if the paused game is advanced by one tick on the next frame.
+	step bool
+
+	// last is a moment of the last frame.
+	last time.Time
+}
+
+// Ticks returns the number of the ticks to simulate on the frame.
+func (c *Clock) Ticks() int {
+	if !c.Paused {
+		return max(c.Scale, 1)
+	}
+	if c.step {
+		c.step = false
+		return 1
+	}
+
+	return 0
+}
+
+// Step advances the paused game by one tick on the next frame.
+func (c *Clock) Step() {
+	if c.Paused {
+		c.step = true
+	}
+}
+
+// NextScale returns the scale following the current one in Scales.
+// The fastest scale is followed by the normal one.
+func (c *Clock) NextScale() int {
+	i := slices.Index(Scales, c.Scale)
+	return Scales[(i+1)%len(Scales)]
+}
+
+// String returns the label of the speed of the game.
+func (c *Clock) String() string {
+	if c.Paused {
+		return "||"
+	}
+
+	return fmt.Sprintf("%dx", max(c.Scale, 1))
+}
+
+// Alpha returns the fraction of the frame that has passed since the last one.
+// The moving entities are drawn by it between their positions at the last two frames.
+func (c *Clock) Alpha() general.Coord {
+	if c.last.IsZero() {
+		return 1
+	}
+
+	return min(general.Coord(time.Since(c.last).Seconds()*TPS), 1)
+}
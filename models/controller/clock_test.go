@@ -0,0 +1,66 @@
+package controller_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gopher-co/td-game/models/controller"
+	"github.com/gopher-co/td-game/models/general"
+)
+
+func TestClock(t *testing.T) {
+	c := controller.Clock{Scale: 1}
+	for _, want := range []int{2, 4, 8, 1} {
+		c.Scale = c.NextScale()
+		if c.Scale != want {
+			t.Fatalf("next scale = %d, want %d", c.Scale, want)
+		}
+		if n := c.Ticks(); n != want {
+			t.Errorf("scale %d: ticks = %d", want, n)
+		}
+	}
+
+	c.Paused = true
+	if n := c.Ticks(); n != 0 {
+		t.Errorf("paused: ticks = %d, want 0", n)
+	}
+	c.Step()
+	if n := c.Ticks(); n != 1 {
+		t.Errorf("step: ticks = %d, want 1", n)
+	}
+	if n := c.Ticks(); n != 0 {
+		t.Errorf("after step: ticks = %d, want 0", n)
+	}
+}
+
+func TestAdvance(t *testing.T) {
+	// the same game at the different speeds is the same after the same number of ticks
+	games := make([]*controller.Controller, len(controller.Scales))
+	for i, scale := range controller.Scales {
+		c := newGame()
+		c.Clock.Scale = scale
+		run(c, controller.PutTower{Name: "Gun", Pos: general.Point{X: 700, Y: 450}}, 0)
+		run(c, controller.StartWave{Wave: 0}, 0)
+		for range 240 / scale {
+			c.Advance()
+		}
+		if c.Time != 240 {
+			t.Fatalf("scale %d: time = %d, want 240", scale, c.Time)
+		}
+		games[i] = c
+	}
+	for i, c := range games[1:] {
+		if got, want := save(t, c), save(t, games[0]); !reflect.DeepEqual(got, want) {
+			t.Errorf("scale %d: game = %+v, want %+v", controller.Scales[i+1], got, want)
+		}
+	}
+
+	// the actions are applied to the paused game, but the time doesn't go on
+	c := newGame()
+	c.Clock.Paused = true
+	run(c, controller.PutTower{Name: "Gun", Pos: general.Point{X: 700, Y: 450}}, 0)
+	c.Advance()
+	if len(c.Map.Towers) != 1 || c.Time != 0 {
+		t.Errorf("paused game: %d towers, time %d, want 1 tower at time 0", len(c.Map.Towers), c.Time)
+	}
+}
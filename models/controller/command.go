@@ -0,0 +1,432 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+	"github.com/gopher-co/td-game/replay"
+)
+
+// Command is a player's action on the game.
+//
+// The commands go through the input encoded as the replay actions,
+// so the local, the co-op and the replay games apply them the same way.
+type Command interface {
+	// Validate returns an error if the command can't be applied to the game now.
+	Validate(c *Controller) error
+
+	// Apply applies the validated command to the game.
+	Apply(c *Controller)
+
+	// Action returns the type and the info of the replay action of the command.
+	Action() (replay.ActionType, any)
+}
+
+// Encode returns the replay action of the command performed at the frame f.
+func Encode(f general.Frames, cmd Command) replay.Action {
+	at, info := cmd.Action()
+	return replay.Action{F: f, Type: at, Info: info}
+}
+
+// Decode returns the command of the replay action.
+func Decode(a replay.Action) (Command, error) {
+	switch info := a.Info.(type) {
+	case replay.InfoPutTower:
+		return PutTower{Name: info.Name, Pos: general.Point{X: general.Coord(info.X), Y: general.Coord(info.Y)}}, nil
+	case replay.InfoSellTower:
+		return SellTower{Index: info.Index}, nil
+	case replay.InfoUpgradeTower:
+		return UpgradeTower{Index: info.Index, Upgrade: info.Upgrade}, nil
+	case replay.InfoTurnOnTower:
+		return TurnTower{Index: info.Index, On: true}, nil
+	case replay.InfoTurnOffTower:
+		return TurnTower{Index: info.Index, On: false}, nil
+	case replay.InfoTune:
+		return TuneTower{Index: info.Index, Aim: info.Aim}, nil
+	case replay.InfoUseTowerAbility:
+		return UseTowerAbility{Index: info.Index}, nil
+	case replay.InfoUseAbility:
+		return UseAbility{Name: info.Name, Target: general.Point{X: general.Coord(info.X), Y: general.Coord(info.Y)}}, nil
+	case replay.InfoStartWave:
+		return StartWave{Wave: info.Wave}, nil
+	case replay.InfoUndo:
+		return Undo{Depth: info.Depth}, nil
+	case replay.InfoStop:
+		return Stop{}, nil
+	default:
+		return nil, fmt.Errorf("action type %d has no command", a.Type)
+	}
+}
+
+// Execute validates the command and applies it to the game.
+// The applied command is recorded in the watcher at the current time.
+func (c *Controller) Execute(cmd Command) error {
+	if err := cmd.Validate(c); err != nil {
+		return err
+	}
+
+	cmd.Apply(c)
+	if _, ok := cmd.(Stop); !ok {
+		at, info := cmd.Action()
+		c.Watcher.Append(c.Time, at, info)
+	}
+
+	return nil
+}
+
+// errNotRunning is returned by the commands that can be applied only during the wave.
+var errNotRunning = errors.New("the wave isn't running")
+
+// tower returns the tower with the index or an error if there is no such tower or it is sold.
+func (c *Controller) tower(i int) (*ingame.Tower, error) {
+	t := c.Tower(i)
+	if t == nil {
+		return nil, fmt.Errorf("tower %d doesn't exist", i)
+	}
+
+	return t, nil
+}
+
+// PutTower is a command that buys the tower with the name and puts it at the position.
+type PutTower struct {
+	// Name is a name of the tower.
+	Name string
+
+	// Pos is a position of the tower.
+	Pos general.Point
+}
+
+// Validate checks that the tower can be bought and put at the position.
+func (p PutTower) Validate(c *Controller) error {
+	tt, ok := c.TowersToBuy[p.Name]
+	if !ok {
+		return fmt.Errorf("tower %v can't be bought", p.Name)
+	}
+	if c.PlayerMapState.Money < tt.Price {
+		return fmt.Errorf("not enough money for tower %v", p.Name)
+	}
+	if !c.Map.CanPlaceTower(p.Pos) {
+		return fmt.Errorf("tower %v can't be placed at %v", p.Name, p.Pos)
+	}
+
+	return nil
+}
+
+// Apply buys the tower and builds it on the map.
+func (p PutTower) Apply(c *Controller) {
+	tt := c.TowersToBuy[p.Name]
+	t := ingame.NewTower(tt, p.Pos)
+	t.MaxUpgrades = c.Rules.MaxUpgrades
+	c.PlayerMapState.Money -= tt.Price
+	c.Map.Build(t)
+
+	c.remember(func() {
+		c.Map.Unbuild(t)
+		c.PlayerMapState.Money += tt.Price
+	})
+}
+
+// Action returns replay.PutTower.
+func (p PutTower) Action() (replay.ActionType, any) {
+	return replay.PutTower, replay.InfoPutTower{Name: p.Name, X: int(p.Pos.X), Y: int(p.Pos.Y)}
+}
+
+// SellTower is a command that sells the tower with the index.
+type SellTower struct {
+	// Index is an index of the tower.
+	Index int
+}
+
+// Validate checks that the tower exists.
+func (s SellTower) Validate(c *Controller) error {
+	_, err := c.tower(s.Index)
+	return err
+}
+
+// Apply refunds the tower and marks it sold.
+// The sold tower stays on the map so the indexes don't change.
+func (s SellTower) Apply(c *Controller) {
+	t := c.Tower(s.Index)
+	refund := c.Rules.Refund(t.Price + t.SpentOnUpgrades())
+	c.PlayerMapState.Money += refund
+	c.TowersSold++
+	t.Sold = true
+
+	c.remember(func() {
+		c.PlayerMapState.Money -= refund
+		c.TowersSold--
+		t.Sold = false
+	})
+}
+
+// Action returns replay.SellTower.
+func (s SellTower) Action() (replay.ActionType, any) {
+	return replay.SellTower, replay.InfoSellTower{Index: s.Index}
+}
+
+// UpgradeTower is a command that buys the upgrade of the tower with the index.
+type UpgradeTower struct {
+	// Index is an index of the tower.
+	Index int
+
+	// Upgrade is an id of the upgrade, empty for the first available one.
+	Upgrade string
+}
+
+// Validate checks that the upgrade is available, open and affordable.
+func (u UpgradeTower) Validate(c *Controller) error {
+	t, err := c.tower(u.Index)
+	if err != nil {
+		return err
+	}
+
+	upg := t.NextUpgrade(u.Upgrade)
+	if upg == nil {
+		return fmt.Errorf("tower %d: upgrade %q isn't available", u.Index, u.Upgrade)
+	}
+	if c.LevelsComplete != nil && upg.OpenLevel != "" {
+		if _, ok := c.LevelsComplete[upg.OpenLevel]; !ok {
+			return fmt.Errorf("tower %d: upgrade %q is opened by level %v", u.Index, upg.ID, upg.OpenLevel)
+		}
+	}
+	if upg.Price > c.PlayerMapState.Money {
+		return fmt.Errorf("tower %d: not enough money for upgrade %q", u.Index, upg.ID)
+	}
+
+	return nil
+}
+
+// Apply buys the upgrade.
+func (u UpgradeTower) Apply(c *Controller) {
+	t := c.Tower(u.Index)
+	typ, img := t.Type, t.ProjectileImage
+
+	upg := t.Upgrade(u.Upgrade, c.LevelsComplete)
+	if upg == nil {
+		return
+	}
+	c.PlayerMapState.Money -= upg.Price
+
+	c.remember(func() {
+		t.Bought = t.Bought[:len(t.Bought)-1]
+		t.Type, t.ProjectileImage = typ, img
+		t.UpdateStats()
+		c.PlayerMapState.Money += upg.Price
+	})
+}
+
+// Action returns replay.UpgradeTower.
+func (u UpgradeTower) Action() (replay.ActionType, any) {
+	return replay.UpgradeTower, replay.InfoUpgradeTower{Index: u.Index, Upgrade: u.Upgrade}
+}
+
+// TurnTower is a command that turns the tower with the index on or off.
+type TurnTower struct {
+	// Index is an index of the tower.
+	Index int
+
+	// On is true if the tower is turned on.
+	On bool
+}
+
+// Validate checks that the tower exists.
+func (t TurnTower) Validate(c *Controller) error {
+	_, err := c.tower(t.Index)
+	return err
+}
+
+// Apply turns the tower on or off.
+func (t TurnTower) Apply(c *Controller) {
+	c.Tower(t.Index).State.IsTurnedOn = t.On
+}
+
+// Action returns replay.TurnOn or replay.TurnOff.
+func (t TurnTower) Action() (replay.ActionType, any) {
+	if t.On {
+		return replay.TurnOn, replay.InfoTurnOnTower{Index: t.Index}
+	}
+
+	return replay.TurnOff, replay.InfoTurnOffTower{Index: t.Index}
+}
+
+// TuneTower is a command that sets the tower with the index to aim at the enemy chosen by the aim's strategy.
+type TuneTower struct {
+	// Index is an index of the tower.
+	Index int
+
+	// Aim is a new aim of the tower.
+	Aim ingame.Aim
+}
+
+// Validate checks that the tower exists.
+func (t TuneTower) Validate(c *Controller) error {
+	_, err := c.tower(t.Index)
+	return err
+}
+
+// Apply sets the aim of the tower.
+func (t TuneTower) Apply(c *Controller) {
+	c.Tower(t.Index).State.AimType = t.Aim
+}
+
+// Action returns the tuning action.
+func (t TuneTower) Action() (replay.ActionType, any) {
+	return replay.Tune, replay.InfoTune{Index: t.Index, Aim: t.Aim}
+}
+
+// UseTowerAbility is a command that activates the ability of the tower with the index.
+type UseTowerAbility struct {
+	// Index is an index of the tower.
+	Index int
+}
+
+// Validate checks that the wave is running and the tower's ability is ready.
+func (u UseTowerAbility) Validate(c *Controller) error {
+	t, err := c.tower(u.Index)
+	if err != nil {
+		return err
+	}
+	if c.State != Running {
+		return errNotRunning
+	}
+	if t.Ability == nil || !t.Ability.Ready() {
+		return fmt.Errorf("tower %d: ability isn't ready", u.Index)
+	}
+
+	return nil
+}
+
+// Apply activates the ability.
+func (u UseTowerAbility) Apply(c *Controller) {
+	c.Tower(u.Index).UseAbility()
+}
+
+// Action returns replay.UseTowerAbility.
+func (u UseTowerAbility) Action() (replay.ActionType, any) {
+	return replay.UseTowerAbility, replay.InfoUseTowerAbility{Index: u.Index}
+}
+
+// UseAbility is a command that activates the player's ability with the name aimed at the target.
+type UseAbility struct {
+	// Name is a name of the ability.
+	Name string
+
+	// Target is a point the ability is aimed at.
+	Target general.Point
+}
+
+// Validate checks that the wave is running, the target is on the map and the ability is ready.
+func (u UseAbility) Validate(c *Controller) error {
+	if c.State != Running {
+		return errNotRunning
+	}
+	if u.Target.X < 0 || u.Target.X > config.MapWidth || u.Target.Y < 0 || u.Target.Y > config.MapHeight {
+		return fmt.Errorf("ability %v: target %v is out of the map", u.Name, u.Target)
+	}
+	if a := c.Map.Ability(u.Name); a == nil || !a.Ready() {
+		return fmt.Errorf("ability %v isn't ready", u.Name)
+	}
+
+	return nil
+}
+
+// Apply activates the ability.
+func (u UseAbility) Apply(c *Controller) {
+	c.Map.UseAbility(u.Name, u.Target)
+}
+
+// Action returns replay.UseAbility.
+func (u UseAbility) Action() (replay.ActionType, any) {
+	return replay.UseAbility, replay.InfoUseAbility{Name: u.Name, X: int(u.Target.X), Y: int(u.Target.Y)}
+}
+
+// StartWave is a command that calls the next wave.
+type StartWave struct {
+	// Wave is a number of the wave called.
+	Wave int
+}
+
+// Validate checks that the next wave is ready.
+// The command is rejected if the wave has already been called,
+// so the players calling it at the same time call it once.
+func (s StartWave) Validate(c *Controller) error {
+	if c.State != NextWaveReady || c.CurrentWave+1 >= len(c.GameRule) {
+		return errors.New("the next wave isn't ready")
+	}
+	if s.Wave != c.CurrentWave+1 {
+		return fmt.Errorf("wave %d isn't the next one", s.Wave)
+	}
+
+	return nil
+}
+
+// Apply starts the wave.
+// The actions of the build phase can't be undone after it.
+func (s StartWave) Apply(c *Controller) {
+	c.history = nil
+	c.State = Running
+	c.CurrentWave++
+	c.Map.StartWave(c.CurrentWave)
+}
+
+// Action returns replay.StartWave.
+func (s StartWave) Action() (replay.ActionType, any) {
+	return replay.StartWave, replay.InfoStartWave{Wave: s.Wave}
+}
+
+// Undo is a command that undoes the last action of the build phase with the full refund.
+// The placements, the upgrades and the sells can be undone.
+type Undo struct {
+	// Depth is a number of the actions that could be undone when the command was made.
+	Depth int
+}
+
+// Validate checks that there is an action to undo and it's the one seen by the player.
+// The command is rejected if another action was made or undone since,
+// so the players undoing at the same time undo one action.
+func (u Undo) Validate(c *Controller) error {
+	if c.State != NextWaveReady {
+		return errors.New("only the build phase can be undone")
+	}
+	if len(c.history) == 0 {
+		return errors.New("nothing to undo")
+	}
+	if u.Depth != len(c.history) {
+		return fmt.Errorf("undo of action %d is outdated", u.Depth)
+	}
+
+	return nil
+}
+
+// Apply undoes the last action.
+func (u Undo) Apply(c *Controller) {
+	undo := c.history[len(c.history)-1]
+	c.history = c.history[:len(c.history)-1]
+	undo()
+}
+
+// Action returns replay.Undo.
+func (u Undo) Action() (replay.ActionType, any) {
+	return replay.Undo, replay.InfoUndo{Depth: u.Depth}
+}
+
+// Stop is a command that ends the game.
+type Stop struct{}
+
+// Validate accepts the command.
+func (Stop) Validate(*Controller) error {
+	return nil
+}
+
+// Apply ends the game.
+func (Stop) Apply(c *Controller) {
+	c.Stop()
+}
+
+// Action returns replay.Stop.
+func (Stop) Action() (replay.ActionType, any) {
+	return replay.Stop, replay.InfoStop{Null: nil}
+}
@@ -0,0 +1,187 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/controller"
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+	"github.com/gopher-co/td-game/replay"
+)
+
+func TestCommandEncoding(t *testing.T) {
+	cmds := []controller.Command{
+		controller.PutTower{Name: "Gopher", Pos: general.Point{X: 100, Y: 200}},
+		controller.SellTower{Index: 1},
+		controller.UpgradeTower{Index: 2, Upgrade: "faster"},
+		controller.TurnTower{Index: 3, On: true},
+		controller.TurnTower{Index: 3, On: false},
+		controller.TuneTower{Index: 4, Aim: ingame.Strongest},
+		controller.TuneTower{Index: 4, Aim: ingame.Vulnerable},
+		controller.UseTowerAbility{Index: 5},
+		controller.UseAbility{Name: "Freeze", Target: general.Point{X: 10, Y: 20}},
+		controller.StartWave{Wave: 3},
+		controller.Stop{},
+	}
+
+	for _, cmd := range cmds {
+		b, err := json.Marshal(controller.Encode(7, cmd))
+		if err != nil {
+			t.Fatalf("%#v: %v", cmd, err)
+		}
+
+		var a replay.Action
+		if err := json.Unmarshal(b, &a); err != nil {
+			t.Fatalf("%#v: %v", cmd, err)
+		}
+		if a.F != 7 {
+			t.Errorf("%#v: frame = %d, want 7", cmd, a.F)
+		}
+
+		got, err := controller.Decode(a)
+		if err != nil {
+			t.Fatalf("%#v: %v", cmd, err)
+		}
+		if !reflect.DeepEqual(got, cmd) {
+			t.Errorf("decoded %#v, want %#v", got, cmd)
+		}
+	}
+}
+
+func TestCommandValidate(t *testing.T) {
+	sold := &ingame.Tower{Sold: true}
+	tower := &ingame.Tower{
+		Upgrades: []*ingame.Upgrade{{ID: "cheap", Price: 10}, {ID: "locked", OpenLevel: "2. Hard"}},
+	}
+
+	c := &controller.Controller{
+		Map:            &ingame.Map{Towers: []*ingame.Tower{tower, sold}},
+		State:          controller.NextWaveReady,
+		CurrentWave:    0,
+		GameRule:       make(ingame.GameRule, 3),
+		PlayerMapState: ingame.PlayerMapState{Money: 5},
+		LevelsComplete: map[string]ingame.Difficulties{},
+	}
+
+	tests := []struct {
+		cmd   controller.Command
+		valid bool
+	}{
+		{cmd: controller.SellTower{Index: 0}, valid: true},
+		{cmd: controller.SellTower{Index: 1}},
+		{cmd: controller.SellTower{Index: 2}},
+		{cmd: controller.TuneTower{Index: 0, Aim: ingame.Last}, valid: true},
+		{cmd: controller.UpgradeTower{Index: 0, Upgrade: "cheap"}},
+		{cmd: controller.UpgradeTower{Index: 0, Upgrade: "locked"}},
+		{cmd: controller.UpgradeTower{Index: 0, Upgrade: "missing"}},
+		{cmd: controller.UseTowerAbility{Index: 0}},
+		{cmd: controller.UseAbility{Name: "Freeze"}},
+		{cmd: controller.StartWave{Wave: 1}, valid: true},
+		{cmd: controller.StartWave{Wave: 0}},
+		{cmd: controller.StartWave{Wave: 2}},
+		{cmd: controller.Stop{}, valid: true},
+	}
+
+	for _, tt := range tests {
+		if err := tt.cmd.Validate(c); (err == nil) != tt.valid {
+			t.Errorf("%#v: Validate() = %v, want valid %v", tt.cmd, err, tt.valid)
+		}
+	}
+}
+
+func TestUseAbilityTarget(t *testing.T) {
+	c := &controller.Controller{
+		Map:   &ingame.Map{Abilities: ingame.NewAbilities([]config.Ability{{Name: "Freeze"}})},
+		State: controller.Running,
+	}
+
+	tests := []struct {
+		target general.Point
+		valid  bool
+	}{
+		{target: general.Point{X: 0, Y: 0}, valid: true},
+		{target: general.Point{X: config.MapWidth, Y: config.MapHeight}, valid: true},
+		{target: general.Point{X: 700, Y: 450}, valid: true},
+		{target: general.Point{X: -1, Y: 450}},
+		{target: general.Point{X: config.MapWidth + 1, Y: 450}},
+		{target: general.Point{X: 700, Y: -1}},
+		{target: general.Point{X: 700, Y: config.MapHeight + 1}},
+		{target: general.Point{X: -1, Y: -1}},
+	}
+
+	for _, tt := range tests {
+		if err := (controller.UseAbility{Name: "Freeze", Target: tt.target}).Validate(c); (err == nil) != tt.valid {
+			t.Errorf("target %v: Validate() = %v, want valid %v", tt.target, err, tt.valid)
+		}
+	}
+}
+
+func TestUndo(t *testing.T) {
+	tower := &ingame.Tower{
+		Price:    50,
+		Upgrades: []*ingame.Upgrade{{ID: "big", Price: 520, DeltaDamage: 10}},
+	}
+
+	c := &controller.Controller{
+		Map:            &ingame.Map{Towers: []*ingame.Tower{tower}},
+		State:          controller.NextWaveReady,
+		CurrentWave:    -1,
+		GameRule:       make(ingame.GameRule, 2),
+		PlayerMapState: ingame.PlayerMapState{Money: 600},
+		Watcher:        &replay.Watcher{},
+	}
+
+	if err := c.Execute(controller.UpgradeTower{Index: 0, Upgrade: "big"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Execute(controller.SellTower{Index: 0}); err != nil {
+		t.Fatal(err)
+	}
+	if c.UndoDepth() != 2 {
+		t.Fatalf("undo depth = %d, want 2", c.UndoDepth())
+	}
+
+	if err := c.Execute(controller.Undo{Depth: 1}); err == nil {
+		t.Error("outdated undo is applied")
+	}
+	for depth := 2; depth > 0; depth-- {
+		if err := c.Execute(controller.Undo{Depth: depth}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if tower.Sold || len(tower.Bought) != 0 || tower.Damage != 0 {
+		t.Errorf("tower isn't restored: sold %v, bought %d, damage %d", tower.Sold, len(tower.Bought), tower.Damage)
+	}
+	if c.PlayerMapState.Money != 600 || c.TowersSold != 0 {
+		t.Errorf("money = %d, towers sold = %d, want 600 and 0", c.PlayerMapState.Money, c.TowersSold)
+	}
+	if err := c.Execute(controller.Undo{Depth: 0}); err == nil {
+		t.Error("undo with the empty history is applied")
+	}
+
+	if err := c.Execute(controller.SellTower{Index: 0}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Execute(controller.StartWave{Wave: 0}); err != nil {
+		t.Fatal(err)
+	}
+	if c.UndoDepth() != 0 {
+		t.Errorf("undo depth after the wave start = %d, want 0", c.UndoDepth())
+	}
+
+	want := []replay.ActionType{
+		replay.UpgradeTower, replay.SellTower, replay.Undo, replay.Undo, replay.SellTower, replay.StartWave,
+	}
+	if len(c.Watcher.Actions) != len(want) {
+		t.Fatalf("recorded %d actions, want %d", len(c.Watcher.Actions), len(want))
+	}
+	for i, a := range c.Watcher.Actions {
+		if a.Type != want[i] {
+			t.Errorf("action %d = %v, want %v", i, a.Type, want[i])
+		}
+	}
+}
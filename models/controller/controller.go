@@ -0,0 +1,298 @@
+// Package controller provides the game controller shared by the local, the co-op and the replay games.
+//
+// The controller owns the simulation of the level. It takes the player's commands
+// from an Input and applies them the same way whatever the source is.
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+	"github.com/gopher-co/td-game/replay"
+)
+
+// CurrentState is an enum that represents the current state of the game.
+type CurrentState int
+
+const (
+	// Running is the state when the game is running.
+	Running CurrentState = iota
+
+	// NextWaveReady is the state when the next wave is ready.
+	NextWaveReady
+)
+
+// Controller is a struct that represents the simulation of the game on the level.
+type Controller struct {
+	// LevelName is a name of the level.
+	LevelName string
+
+	// Map is a map of the game.
+	Map *ingame.Map
+
+	// TowersToBuy is a map of towers that can be bought.
+	TowersToBuy map[string]*config.Tower
+
+	// EnemyToCall is a map of enemies that can be called.
+	EnemyToCall map[string]*config.Enemy
+
+	// Ended is a flag that represents if the game is ended.
+	Ended bool
+
+	// Win is a flag that represents if the game is won.
+	Win bool
+
+	// TowersSold is a number of the towers sold during the game.
+	TowersSold int
+
+	// State is a current state of the game.
+	State CurrentState
+
+	// CurrentWave is a number of the current wave.
+	CurrentWave int
+
+	// GameRule is a game rule of the game.
+	GameRule ingame.GameRule
+
+	// Rules are the rule modifiers of the level.
+	Rules config.Rules
+
+	// Time is a time of the game.
+	Time general.Frames
+
+	// Tick is a number of the ticks the game has been updated by.
+	// Unlike the time, it goes on between the waves, so the co-op players apply the commands at the same tick.
+	Tick general.Frames
+
+	// PlayerMapState is a state of the player on the map.
+	PlayerMapState ingame.PlayerMapState
+
+	// LevelsComplete is a set of the levels completed by the player.
+	// The upgrades opened by the levels not completed can't be bought.
+	// If it's nil, all the upgrades are open.
+	LevelsComplete map[string]ingame.Difficulties
+
+	// Watcher records the actions applied to the game.
+	Watcher *replay.Watcher
+
+	// Input is a source of the player's actions.
+	Input Input
+
+	// Clock decides how many ticks the game is advanced by on each frame.
+	Clock Clock
+
+	// history is a list of the functions undoing the actions made in the current build phase.
+	history []func()
+
+	// endless is a generator of the waves after the scripted ones.
+	// It is nil if the endless mode is off.
+	endless *ingame.Endless
+}
+
+// New creates a new entity of Controller.
+// The player starts the level with the state start.
+func New(
+	level *config.Level,
+	m *config.Map,
+	towers map[string]*config.Tower,
+	enemies map[string]*config.Enemy,
+	start ingame.PlayerMapState,
+	input Input,
+) *Controller {
+	c := &Controller{
+		LevelName:      level.LevelName,
+		Map:            ingame.NewMap(m),
+		TowersToBuy:    towers,
+		EnemyToCall:    enemies,
+		State:          NextWaveReady,
+		CurrentWave:    -1,
+		GameRule:       ingame.NewGameRule(level.GameRule),
+		Rules:          level.LevelRules(),
+		PlayerMapState: start,
+		Watcher: &replay.Watcher{
+			Name:               level.LevelName,
+			InitPlayerMapState: start,
+			Actions:            make([]replay.Action, 0, 2500),
+		},
+		Input: input,
+		Clock: Clock{Scale: 1},
+	}
+
+	c.Map.Subscribe(c.PlayerMapState.Handle)
+	c.Map.Abilities = ingame.NewAbilities(level.Abilities)
+
+	return c
+}
+
+// Advance advances the game by one frame: by as many ticks as the clock says.
+// The lockstep input can hold the game back or let it catch up.
+// The actions from the input are applied even if the game is paused.
+func (c *Controller) Advance() {
+	c.Map.KeepPositions()
+
+	n := c.Clock.Ticks()
+	if ls, ok := c.Input.(Lockstep); ok {
+		n = ls.Ticks(c, n)
+	}
+	if n == 0 && !c.Ended {
+		c.apply()
+	}
+	for range n {
+		c.Update()
+	}
+
+	c.Clock.last = time.Now()
+}
+
+// Update applies the actions from the input and updates the game by one tick.
+func (c *Controller) Update() {
+	if c.Ended {
+		return
+	}
+
+	c.apply()
+	c.Tick++
+	if c.Ended || c.State != Running {
+		return
+	}
+
+	c.Map.Update()
+
+	if c.PlayerMapState.Dead() {
+		c.Stop()
+		return
+	}
+
+	wave := c.GameRule[c.CurrentWave]
+	for _, sw := range wave.CallEnemies() {
+		c.Map.Spawn(sw.NewEnemy(c.EnemyToCall[sw.EnemyName], c.Map.Paths, c.Rules.EnemyHealthMultiplier))
+	}
+	c.Map.SpawnAdds(c.EnemyToCall)
+
+	if wave.Ended() && !c.Map.AreThereAliveEnemies() {
+		c.clearWave()
+		return
+	}
+
+	c.Time++
+}
+
+// apply applies the actions from the input.
+func (c *Controller) apply() {
+	for _, a := range c.Input.Poll(c) {
+		// the invalid commands are dropped, e.g. the tower sold by two co-op players is sold once
+		if cmd, err := Decode(a); err == nil {
+			_ = c.Execute(cmd)
+		}
+		if c.Ended {
+			return
+		}
+	}
+}
+
+// Seed seeds the random numbers of the game and records the seed in the watcher.
+// The game played again with the same seed and the same actions goes the same way.
+func (c *Controller) Seed(seed uint64) {
+	c.Map.Random = ingame.NewRandom(seed)
+	c.Watcher.Seed = seed
+}
+
+// EnableEndless turns on the endless mode.
+// After the scripted waves the game goes on with the waves generated from the seed of the game.
+// Returns an error if the level has no enemies or no paths for the endless waves.
+func (c *Controller) EnableEndless() error {
+	e, err := ingame.NewEndless(c.Watcher.Seed, len(c.GameRule), c.EnemyToCall, c.Map.Paths)
+	if err != nil {
+		return err
+	}
+
+	c.endless = e
+	c.Watcher.Endless = true
+
+	return nil
+}
+
+// Stop ends the game and records it in the watcher.
+func (c *Controller) Stop() {
+	if c.Ended {
+		return
+	}
+
+	c.Ended = true
+	c.Watcher.Append(c.Time, replay.Stop, replay.InfoStop{Null: nil})
+}
+
+// End returns true if the game is ended.
+func (c *Controller) End() bool {
+	return c.Ended
+}
+
+// WaveLabel returns the label of the current wave.
+func (c *Controller) WaveLabel() string {
+	if c.CurrentWave < 0 || c.CurrentWave >= len(c.GameRule) {
+		return ""
+	}
+	if c.endless != nil {
+		return fmt.Sprintf("Wave: %d", c.CurrentWave+1)
+	}
+
+	return fmt.Sprintf("Wave: %d/%d", c.CurrentWave+1, len(c.GameRule))
+}
+
+// Tower returns the tower with the index or nil if there is no such tower or it is sold.
+func (c *Controller) Tower(i int) *ingame.Tower {
+	if i < 0 || i >= len(c.Map.Towers) || c.Map.Towers[i].Sold {
+		return nil
+	}
+
+	return c.Map.Towers[i]
+}
+
+// TowerIndex returns the index of the tower t or -1 if it's not on the map.
+// The sold towers stay on the map so the indexes don't change during the game.
+func (c *Controller) TowerIndex(t *ingame.Tower) int {
+	for k, v := range c.Map.Towers {
+		if v == t {
+			return k
+		}
+	}
+
+	return -1
+}
+
+// UndoDepth returns the number of the actions that can be undone now.
+func (c *Controller) UndoDepth() int {
+	return len(c.history)
+}
+
+// remember adds the function undoing the action to the history.
+// Only the actions of the build phase can be undone.
+func (c *Controller) remember(undo func()) {
+	if c.State == NextWaveReady {
+		c.history = append(c.history, undo)
+	}
+}
+
+// clearWave sets the state after the wave.
+// After the last wave the game is won and ended unless the endless mode is on.
+func (c *Controller) clearWave() {
+	c.State = NextWaveReady
+	c.Watcher.Append(c.Time, replay.EndWave, replay.InfoEndWave{Wave: c.CurrentWave})
+	c.Map.ClearWave(c.CurrentWave)
+	c.PlayerMapState.Money += c.Map.Income() + c.Rules.WaveReward(c.PlayerMapState.Money)
+
+	if c.CurrentWave != len(c.GameRule)-1 {
+		return
+	}
+
+	c.Win = true
+	if c.endless != nil {
+		c.GameRule = c.endless.Extend(c.GameRule)
+		return
+	}
+
+	c.Stop()
+}
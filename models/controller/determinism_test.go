@@ -0,0 +1,137 @@
+package controller_test
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/controller"
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+// goldenDigest is the digest of the simulation played by simulate.
+// It is the same on every platform; if it changes, the replays recorded before don't play the same way.
+const goldenDigest = 0xbf98b97a8557a722
+
+// newWindingGame creates an endless game on a winding path with the critical hits and the evasion.
+func newWindingGame(input controller.Input) *controller.Controller {
+	level := &config.Level{
+		LevelName: "Test",
+		GameRule: config.GameRule{
+			{Swarms: []config.EnemySwarm{{EnemyName: "#ff0000", Interval: 17, MaxCalls: 9}}},
+			{Swarms: []config.EnemySwarm{{EnemyName: "#00ff00", Interval: 23, MaxCalls: 7}}},
+		},
+	}
+	m := &config.Map{Path: []general.Point{
+		{X: 0, Y: 100}, {X: 413.7, Y: 377.3}, {X: 211.1, Y: 733.9}, {X: 977.3, Y: 1013.3},
+		{X: 1187.9, Y: 211.7}, {X: 1500, Y: 601.1},
+	}}
+	towers := map[string]*config.Tower{
+		"Gun": {
+			Name: "Gun", Price: 50, InitDamage: 3, InitRadius: 233.3, InitSpeedAttack: 17, InitProjectileVrms: 7.7,
+			CritChance: 20, CritDamage: 300,
+		},
+	}
+	enemies := map[string]*config.Enemy{
+		"#ff0000": {Name: "#ff0000", MaxHealth: 20, Vrms: 2.3, Damage: 1, MoneyAward: 5},
+		"#00ff00": {Name: "#00ff00", MaxHealth: 70, Vrms: 1.1, Damage: 2, MoneyAward: 9, Evasion: 25},
+	}
+
+	c := controller.New(level, m, towers, enemies, ingame.PlayerMapState{Health: 100, Money: 1000}, input)
+	c.Seed(7)
+	if err := c.EnableEndless(); err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// simulate plays the winding game with the towers of all the aims until the end and
+// returns the digest of the positions and the health of everything on the map at every tick.
+// The commands are sent if play is true, otherwise the game is played by its input only.
+func simulate(c *controller.Controller, play bool) uint64 {
+	if play {
+		spots := []general.Point{{X: 301.3, Y: 203.9}, {X: 431.1, Y: 611.7}, {X: 700.3, Y: 790.1}, {X: 1011.7, Y: 551.3}, {X: 1333.3, Y: 311.9}}
+		for i, p := range spots {
+			run(c, controller.PutTower{Name: "Gun", Pos: p}, 0)
+			run(c, controller.TuneTower{Index: i, Aim: ingame.Aims[i%len(ingame.Aims)]}, 0)
+		}
+	}
+
+	h := fnv.New64a()
+	b := make([]byte, 0, 4096)
+	for range 20000 {
+		if play && c.State == controller.NextWaveReady {
+			run(c, controller.StartWave{Wave: c.CurrentWave + 1}, 0)
+		}
+		run(c, nil, 1)
+		// the replay is stopped by the recorded action before the last tick is simulated
+		if c.Ended {
+			break
+		}
+
+		b = b[:0]
+		b = binary.LittleEndian.AppendUint64(b, uint64(c.Time))
+		b = binary.LittleEndian.AppendUint64(b, uint64(c.PlayerMapState.Health))
+		b = binary.LittleEndian.AppendUint64(b, uint64(c.PlayerMapState.Money))
+		for _, e := range c.Map.Enemies {
+			b = binary.LittleEndian.AppendUint32(b, math.Float32bits(e.State.Pos.X))
+			b = binary.LittleEndian.AppendUint32(b, math.Float32bits(e.State.Pos.Y))
+			b = binary.LittleEndian.AppendUint64(b, uint64(e.State.Health))
+		}
+		for _, p := range c.Map.Projectiles {
+			b = binary.LittleEndian.AppendUint32(b, math.Float32bits(p.Pos.X))
+			b = binary.LittleEndian.AppendUint32(b, math.Float32bits(p.Pos.Y))
+		}
+		_, _ = h.Write(b)
+	}
+
+	return h.Sum64()
+}
+
+func TestDeterminism(t *testing.T) {
+	c := newWindingGame(controller.NewLocal())
+	d := simulate(c, true)
+	if d != goldenDigest {
+		t.Errorf("digest = %#x, want %#x", d, uint64(goldenDigest))
+	}
+
+	if again := simulate(newWindingGame(controller.NewLocal()), true); again != d {
+		t.Errorf("the same game played again: digest = %#x, want %#x", again, d)
+	}
+	if r := simulate(newWindingGame(controller.NewReplay(c.Watcher)), false); r != d {
+		t.Errorf("the replay of the game: digest = %#x, want %#x", r, d)
+	}
+}
+
+func TestIndependentGames(t *testing.T) {
+	const n = 4
+
+	games := make([]*controller.Controller, n)
+	digests := make([]uint64, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		games[i] = newWindingGame(controller.NewLocal())
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			digests[i] = simulate(games[i], true)
+		}()
+	}
+	wg.Wait()
+
+	for i, c := range games {
+		if digests[i] != goldenDigest {
+			t.Errorf("game %d: digest = %#x, want %#x", i, digests[i], uint64(goldenDigest))
+		}
+		for k, tw := range c.Map.Towers {
+			if tw.Index != k {
+				t.Errorf("game %d: tower %d has index %d", i, k, tw.Index)
+			}
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"github.com/gopher-co/td-game/replay"
+)
+
+// Input is a source of the player's actions.
+type Input interface {
+	// Send sends the action made by the local player through the UI.
+	Send(a replay.Action)
+
+	// Poll returns the actions to be applied on the current tick of the controller.
+	Poll(c *Controller) []replay.Action
+
+	// SetSpeed sets the time scale of the game.
+	SetSpeed(c *Controller, scale int)
+
+	// Close closes the input when the player leaves the game.
+	Close()
+}
+
+// Lockstep is an input of the game shared by several players.
+// The actions come stamped with the ticks they are applied at,
+// so the game can't be advanced past the ticks confirmed by the host.
+type Lockstep interface {
+	Input
+
+	// Ticks returns the number of the ticks the game can be advanced by on the frame.
+	// n is the number of the ticks the clock wants to advance it by.
+	Ticks(c *Controller, n int) int
+}
+
+// Local is an input of the game played on this computer.
+// The actions sent are applied on the next tick.
+type Local struct {
+	// queue is a list of the actions sent since the previous tick.
+	queue []replay.Action
+}
+
+// NewLocal creates a new entity of Local.
+func NewLocal() *Local {
+	return &Local{}
+}
+
+// Send queues the action.
+func (l *Local) Send(a replay.Action) {
+	l.queue = append(l.queue, a)
+}
+
+// Poll returns the queued actions.
+func (l *Local) Poll(_ *Controller) []replay.Action {
+	q := l.queue
+	l.queue = nil
+
+	return q
+}
+
+// SetSpeed sets the time scale of the game.
+func (l *Local) SetSpeed(c *Controller, scale int) {
+	c.Clock.Scale = scale
+}
+
+// Close does nothing.
+func (l *Local) Close() {}
+
+// Replay is an input of the recorded game.
+// The actions of the watcher are applied at the frames they were recorded at.
+type Replay struct {
+	// w is a watcher of the replay.
+	w *replay.Watcher
+
+	// next is an index of the next action.
+	next int
+
+	// autoStart is a flag that shows if the waves are called as soon as possible.
+	// The replays recorded before the waves were called by the actions have it.
+	autoStart bool
+}
+
+// NewReplay creates a new entity of Replay.
+func NewReplay(w *replay.Watcher) *Replay {
+	r := &Replay{w: w, autoStart: true}
+	for _, a := range w.Actions {
+		if a.Type == replay.StartWave {
+			r.autoStart = false
+			break
+		}
+	}
+
+	return r
+}
+
+// Send does nothing: the replay can't be changed.
+func (r *Replay) Send(_ replay.Action) {}
+
+// Poll returns the actions recorded at the current time of the controller.
+func (r *Replay) Poll(c *Controller) []replay.Action {
+	var as []replay.Action
+	if r.autoStart && c.State == NextWaveReady {
+		as = append(as, replay.Action{F: c.Time, Type: replay.StartWave, Info: replay.InfoStartWave{Wave: c.CurrentWave + 1}})
+	}
+
+	for r.next < len(r.w.Actions) && r.w.Actions[r.next].F <= c.Time {
+		a := r.w.Actions[r.next]
+		// the time doesn't go on at the end of the wave,
+		// so the actions of the build phase wait for the wave to end in the replay
+		if a.Type == replay.EndWave {
+			if c.State == Running {
+				break
+			}
+			r.next++
+			continue
+		}
+
+		as = append(as, a)
+		r.next++
+	}
+
+	return as
+}
+
+// Done returns true if all the actions of the replay are polled.
+func (r *Replay) Done() bool {
+	return r.next >= len(r.w.Actions)
+}
+
+// SetSpeed sets the time scale of the game.
+func (r *Replay) SetSpeed(c *Controller, scale int) {
+	c.Clock.Scale = scale
+}
+
+// Close does nothing.
+func (r *Replay) Close() {}
@@ -0,0 +1,121 @@
+package controller_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gopher-co/td-game/models/controller"
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/replay"
+)
+
+func TestReplayPoll(t *testing.T) {
+	put := replay.Action{F: 0, Type: replay.PutTower, Info: replay.InfoPutTower{Name: "Gopher", X: 10, Y: 10}}
+	sell := replay.Action{F: 2, Type: replay.SellTower, Info: replay.InfoSellTower{Index: 0}}
+	start := replay.Action{F: 1, Type: replay.StartWave, Info: replay.InfoStartWave{Wave: 0}}
+
+	tests := []struct {
+		name    string
+		actions []replay.Action
+		state   controller.CurrentState
+		time    general.Frames
+		want    []replay.ActionType
+	}{
+		{
+			name:    "recorded waves",
+			actions: []replay.Action{put, start, sell},
+			state:   controller.NextWaveReady,
+			time:    1,
+			want:    []replay.ActionType{replay.PutTower, replay.StartWave},
+		},
+		{
+			name:    "old replay starts the wave first",
+			actions: []replay.Action{put, sell},
+			state:   controller.NextWaveReady,
+			time:    0,
+			want:    []replay.ActionType{replay.StartWave, replay.PutTower},
+		},
+		{
+			name:    "old replay while running",
+			actions: []replay.Action{put, sell},
+			state:   controller.Running,
+			time:    5,
+			want:    []replay.ActionType{replay.PutTower, replay.SellTower},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := controller.NewReplay(&replay.Watcher{Actions: tt.actions})
+			c := &controller.Controller{State: tt.state, CurrentWave: -1, Time: tt.time}
+
+			got := in.Poll(c)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d actions %v, want %v", len(got), got, tt.want)
+			}
+			for i, a := range got {
+				if a.Type != tt.want[i] {
+					t.Errorf("action %d = %v, want %v", i, a.Type, tt.want[i])
+				}
+			}
+
+			if rest := in.Poll(&controller.Controller{State: controller.Running, Time: c.Time}); len(rest) != 0 {
+				t.Errorf("actions polled twice: %v", rest)
+			}
+		})
+	}
+}
+
+// lockstep is an input that applies the actions at the ticks they are stamped with
+// and confirms the ticks up to confirmed.
+type lockstep struct {
+	controller.Local
+
+	turns     map[general.Frames][]replay.Action
+	confirmed general.Frames
+}
+
+func (l *lockstep) Ticks(c *controller.Controller, n int) int {
+	return min(n, l.confirmed-c.Tick)
+}
+
+func (l *lockstep) Poll(c *controller.Controller) []replay.Action {
+	as := l.turns[c.Tick]
+	delete(l.turns, c.Tick)
+
+	return as
+}
+
+func TestLockstep(t *testing.T) {
+	games := make([]*controller.Controller, 0, len(controller.Scales))
+	for _, scale := range controller.Scales {
+		c := newGame()
+		c.Input = &lockstep{
+			turns: map[general.Frames][]replay.Action{
+				10: {controller.Encode(0, controller.PutTower{Name: "Gun", Pos: general.Point{X: 700, Y: 450}})},
+				25: {controller.Encode(0, controller.StartWave{Wave: 0})},
+			},
+			confirmed: 200,
+		}
+		c.Clock.Scale = scale
+
+		// the game waits for the host at the confirmed tick
+		for range 300 {
+			c.Advance()
+		}
+		if c.Tick != 200 {
+			t.Fatalf("scale %d: tick = %d, want 200", scale, c.Tick)
+		}
+		games = append(games, c)
+	}
+
+	// the time goes on from the tick the wave is started at, whatever the speed is
+	for i, c := range games {
+		if c.Time != 175 || len(c.Map.Towers) != 1 {
+			t.Errorf("scale %d: time = %d, %d towers, want time 175 and 1 tower", controller.Scales[i], c.Time, len(c.Map.Towers))
+		}
+		if got, want := save(t, c), save(t, games[0]); !reflect.DeepEqual(got, want) {
+			t.Errorf("scale %d: game = %+v, want %+v", controller.Scales[i], got, want)
+		}
+	}
+}
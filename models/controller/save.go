@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+	"github.com/gopher-co/td-game/replay"
+)
+
+// Save is a saved unfinished game: the state of the simulation at the moment of the save.
+// The game is restored from it without being simulated again.
+type Save struct {
+	// Watcher is the replay of the game so far. The restored game goes on recording it.
+	Watcher *replay.Watcher `json:"replay"`
+
+	// Time is a time of the game.
+	Time general.Frames `json:"time"`
+
+	// Tick is a number of the ticks the game has been updated by.
+	Tick general.Frames `json:"tick"`
+
+	// State is a current state of the game.
+	State CurrentState `json:"state"`
+
+	// CurrentWave is a number of the current wave.
+	CurrentWave int `json:"current_wave"`
+
+	// Win is a flag that shows if the scripted waves of the endless game are won.
+	Win bool `json:"win"`
+
+	// TowersSold is a number of the towers sold during the game.
+	TowersSold int `json:"towers_sold"`
+
+	// PlayerMapState is a state of the player on the map.
+	PlayerMapState ingame.PlayerMapState `json:"player_map_state"`
+
+	// GameRule are the waves of the game with the progress of their swarms.
+	GameRule ingame.GameRule `json:"game_rule"`
+
+	// Map is a state of the entities on the map.
+	Map ingame.MapSave `json:"map"`
+}
+
+// Save returns the save of the game.
+// The game stopped by the player is saved as if it wasn't stopped.
+func (c *Controller) Save() (*Save, error) {
+	m, err := c.Map.Save()
+	if err != nil {
+		return nil, err
+	}
+
+	w := *c.Watcher
+	w.Actions = slices.DeleteFunc(slices.Clone(w.Actions), func(a replay.Action) bool {
+		return a.Type == replay.Stop
+	})
+
+	return &Save{
+		Watcher:        &w,
+		Time:           c.Time,
+		Tick:           c.Tick,
+		State:          c.State,
+		CurrentWave:    c.CurrentWave,
+		Win:            c.Win,
+		TowersSold:     c.TowersSold,
+		PlayerMapState: c.PlayerMapState,
+		GameRule:       c.GameRule.Clone(),
+		Map:            m,
+	}, nil
+}
+
+// Restore brings the new game to the state of the save.
+// The game goes on with its input after that.
+// The actions of the build phase made before the save can't be undone.
+//
+// Returns an error if the save doesn't match the game.
+func (c *Controller) Restore(s *Save) error {
+	if c.Tick != 0 || c.CurrentWave != -1 {
+		return errors.New("only a new game can be restored")
+	}
+	if s.Watcher == nil || s.Watcher.Name != c.LevelName {
+		return fmt.Errorf("the save isn't a game on level %s", c.LevelName)
+	}
+	if s.CurrentWave < -1 || s.CurrentWave >= len(s.GameRule) || s.State == Running && s.CurrentWave == -1 {
+		return fmt.Errorf("wave %d of %d waves can't be current", s.CurrentWave, len(s.GameRule))
+	}
+
+	if err := c.Map.Restore(s.Map, c.TowersToBuy, c.EnemyToCall); err != nil {
+		return fmt.Errorf("map not restored: %w", err)
+	}
+
+	w := *s.Watcher
+	w.Actions = slices.Clone(w.Actions)
+	c.Watcher = &w
+	c.Time = s.Time
+	c.Tick = s.Tick
+	c.State = s.State
+	c.CurrentWave = s.CurrentWave
+	c.Win = s.Win
+	c.TowersSold = s.TowersSold
+	c.PlayerMapState = s.PlayerMapState
+	c.GameRule = s.GameRule.Clone()
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/controller"
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+// newGame creates a game of two waves on a straight path.
+func newGame() *controller.Controller {
+	level := &config.Level{
+		LevelName: "Test",
+		GameRule: config.GameRule{
+			{Swarms: []config.EnemySwarm{{EnemyName: "#ff0000", Interval: 30, MaxCalls: 5}}},
+			{Swarms: []config.EnemySwarm{{EnemyName: "#ff0000", Interval: 20, MaxCalls: 8}}},
+		},
+	}
+	m := &config.Map{Path: []general.Point{{X: 0, Y: 540}, {X: 1500, Y: 540}}}
+	towers := map[string]*config.Tower{
+		"Gun": {Name: "Gun", Price: 50, InitDamage: 3, InitRadius: 300, InitSpeedAttack: 15, InitProjectileVrms: 8},
+	}
+	enemies := map[string]*config.Enemy{
+		"#ff0000": {Name: "#ff0000", MaxHealth: 20, Vrms: 2, Damage: 1, MoneyAward: 5},
+	}
+
+	return controller.New(level, m, towers, enemies, ingame.PlayerMapState{Health: 20, Money: 200}, controller.NewLocal())
+}
+
+// save returns the save of the game or fails the test.
+func save(t *testing.T, c *controller.Controller) *controller.Save {
+	t.Helper()
+	s, err := c.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+// run sends the command and updates the game n times.
+func run(c *controller.Controller, cmd controller.Command, n int) {
+	if cmd != nil {
+		c.Input.Send(controller.Encode(c.Time, cmd))
+	}
+	for range n {
+		c.Update()
+	}
+}
+
+func TestSaveRestore(t *testing.T) {
+	c := newGame()
+	run(c, controller.PutTower{Name: "Gun", Pos: general.Point{X: 700, Y: 450}}, 1)
+	run(c, controller.StartWave{Wave: 0}, 1)
+	for c.State == controller.Running {
+		run(c, nil, 1)
+	}
+	run(c, controller.PutTower{Name: "Gun", Pos: general.Point{X: 300, Y: 630}}, 1)
+	run(c, controller.StartWave{Wave: 1}, 100)
+	c.Stop()
+
+	b, err := json.Marshal(save(t, c))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s controller.Save
+	if err := json.Unmarshal(b, &s); err != nil {
+		t.Fatal(err)
+	}
+
+	r := newGame()
+	if err := r.Restore(&s); err != nil {
+		t.Fatal(err)
+	}
+	if got := save(t, r); !reflect.DeepEqual(got, &s) {
+		t.Errorf("restored game = %+v, want %+v", got, &s)
+	}
+	if r.Ended || r.State != controller.Running || r.CurrentWave != 1 {
+		t.Fatalf("restored game: ended %v, state %v, wave %d", r.Ended, r.State, r.CurrentWave)
+	}
+
+	// the restored game goes on the same way as the one that wasn't stopped
+	c.Ended = false
+	run(c, nil, 200)
+	run(r, nil, 200)
+	if got, want := save(t, r), save(t, c); !reflect.DeepEqual(got, want) {
+		t.Errorf("restored game = %+v, want %+v", got, want)
+	}
+}
+
+func TestRestoreBroken(t *testing.T) {
+	c := newGame()
+	run(c, controller.PutTower{Name: "Gun", Pos: general.Point{X: 700, Y: 450}}, 1)
+	run(c, controller.StartWave{Wave: 0}, 100)
+
+	tests := []struct {
+		name   string
+		breaks func(s *controller.Save)
+	}{
+		{"level", func(s *controller.Save) { s.Watcher.Name = "Other" }},
+		{"wave", func(s *controller.Save) { s.CurrentWave = 2 }},
+		{"tower", func(s *controller.Save) { s.Map.Towers[0].Name = "Laser" }},
+		{"upgrade", func(s *controller.Save) { s.Map.Towers[0].Upgrades = []string{"none"} }},
+		{"target", func(s *controller.Save) { s.Map.Towers[0].Aim = len(s.Map.Enemies) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := save(t, c)
+			tt.breaks(s)
+			if err := newGame().Restore(s); err == nil {
+				t.Error("broken save is restored")
+			}
+		})
+	}
+}
@@ -20,55 +20,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type TuneTowerRequest_Aim int32
-
-const (
-	TuneTowerRequest_AIM_TOWER_AT_FIRST  TuneTowerRequest_Aim = 0
-	TuneTowerRequest_AIM_TOWER_AT_STRONG TuneTowerRequest_Aim = 1
-	TuneTowerRequest_AIM_TOWER_AT_LAST   TuneTowerRequest_Aim = 2
-)
-
-// Enum value maps for TuneTowerRequest_Aim.
-var (
-	TuneTowerRequest_Aim_name = map[int32]string{
-		0: "AIM_TOWER_AT_FIRST",
-		1: "AIM_TOWER_AT_STRONG",
-		2: "AIM_TOWER_AT_LAST",
-	}
-	TuneTowerRequest_Aim_value = map[string]int32{
-		"AIM_TOWER_AT_FIRST":  0,
-		"AIM_TOWER_AT_STRONG": 1,
-		"AIM_TOWER_AT_LAST":   2,
-	}
-)
-
-func (x TuneTowerRequest_Aim) Enum() *TuneTowerRequest_Aim {
-	p := new(TuneTowerRequest_Aim)
-	*p = x
-	return p
-}
-
-func (x TuneTowerRequest_Aim) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (TuneTowerRequest_Aim) Descriptor() protoreflect.EnumDescriptor {
-	return file_client_proto_enumTypes[0].Descriptor()
-}
-
-func (TuneTowerRequest_Aim) Type() protoreflect.EnumType {
-	return &file_client_proto_enumTypes[0]
-}
-
-func (x TuneTowerRequest_Aim) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use TuneTowerRequest_Aim.Descriptor instead.
-func (TuneTowerRequest_Aim) EnumDescriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{16, 0}
-}
-
 type FetchLevelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -381,17 +332,16 @@ func (x *SendGameStateResponse) GetStatus() Status {
 	return Status_OK
 }
 
-type PutTowerRequest struct {
+type SpeedGameUpRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TowerName string `protobuf:"bytes,1,opt,name=tower_name,json=towerName,proto3" json:"tower_name,omitempty"`
-	Point     *Point `protobuf:"bytes,2,opt,name=point,proto3" json:"point,omitempty"`
+	Scale int32 `protobuf:"varint,1,opt,name=scale,proto3" json:"scale,omitempty"`
 }
 
-func (x *PutTowerRequest) Reset() {
-	*x = PutTowerRequest{}
+func (x *SpeedGameUpRequest) Reset() {
+	*x = SpeedGameUpRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_client_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -399,13 +349,13 @@ func (x *PutTowerRequest) Reset() {
 	}
 }
 
-func (x *PutTowerRequest) String() string {
+func (x *SpeedGameUpRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutTowerRequest) ProtoMessage() {}
+func (*SpeedGameUpRequest) ProtoMessage() {}
 
-func (x *PutTowerRequest) ProtoReflect() protoreflect.Message {
+func (x *SpeedGameUpRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_client_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -417,382 +367,18 @@ func (x *PutTowerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutTowerRequest.ProtoReflect.Descriptor instead.
-func (*PutTowerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SpeedGameUpRequest.ProtoReflect.Descriptor instead.
+func (*SpeedGameUpRequest) Descriptor() ([]byte, []int) {
 	return file_client_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PutTowerRequest) GetTowerName() string {
+func (x *SpeedGameUpRequest) GetScale() int32 {
 	if x != nil {
-		return x.TowerName
-	}
-	return ""
-}
-
-func (x *PutTowerRequest) GetPoint() *Point {
-	if x != nil {
-		return x.Point
-	}
-	return nil
-}
-
-type UpgradeTowerRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Tower *TowerId `protobuf:"bytes,1,opt,name=tower,proto3" json:"tower,omitempty"`
-}
-
-func (x *UpgradeTowerRequest) Reset() {
-	*x = UpgradeTowerRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *UpgradeTowerRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpgradeTowerRequest) ProtoMessage() {}
-
-func (x *UpgradeTowerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpgradeTowerRequest.ProtoReflect.Descriptor instead.
-func (*UpgradeTowerRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *UpgradeTowerRequest) GetTower() *TowerId {
-	if x != nil {
-		return x.Tower
-	}
-	return nil
-}
-
-type TurnTowerOnRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Tower *TowerId `protobuf:"bytes,1,opt,name=tower,proto3" json:"tower,omitempty"`
-}
-
-func (x *TurnTowerOnRequest) Reset() {
-	*x = TurnTowerOnRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *TurnTowerOnRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TurnTowerOnRequest) ProtoMessage() {}
-
-func (x *TurnTowerOnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TurnTowerOnRequest.ProtoReflect.Descriptor instead.
-func (*TurnTowerOnRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *TurnTowerOnRequest) GetTower() *TowerId {
-	if x != nil {
-		return x.Tower
-	}
-	return nil
-}
-
-type ChangeTowerAimTypeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Tower      *TowerId `protobuf:"bytes,1,opt,name=tower,proto3" json:"tower,omitempty"`
-	NewAimType int32    `protobuf:"varint,2,opt,name=new_aim_type,json=newAimType,proto3" json:"new_aim_type,omitempty"`
-}
-
-func (x *ChangeTowerAimTypeRequest) Reset() {
-	*x = ChangeTowerAimTypeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ChangeTowerAimTypeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ChangeTowerAimTypeRequest) ProtoMessage() {}
-
-func (x *ChangeTowerAimTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ChangeTowerAimTypeRequest.ProtoReflect.Descriptor instead.
-func (*ChangeTowerAimTypeRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *ChangeTowerAimTypeRequest) GetTower() *TowerId {
-	if x != nil {
-		return x.Tower
-	}
-	return nil
-}
-
-func (x *ChangeTowerAimTypeRequest) GetNewAimType() int32 {
-	if x != nil {
-		return x.NewAimType
+		return x.Scale
 	}
 	return 0
 }
 
-type TurnTowerOffRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Tower *TowerId `protobuf:"bytes,1,opt,name=tower,proto3" json:"tower,omitempty"`
-}
-
-func (x *TurnTowerOffRequest) Reset() {
-	*x = TurnTowerOffRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *TurnTowerOffRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TurnTowerOffRequest) ProtoMessage() {}
-
-func (x *TurnTowerOffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TurnTowerOffRequest.ProtoReflect.Descriptor instead.
-func (*TurnTowerOffRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *TurnTowerOffRequest) GetTower() *TowerId {
-	if x != nil {
-		return x.Tower
-	}
-	return nil
-}
-
-type SellTowerRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Tower *TowerId `protobuf:"bytes,1,opt,name=tower,proto3" json:"tower,omitempty"`
-}
-
-func (x *SellTowerRequest) Reset() {
-	*x = SellTowerRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SellTowerRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SellTowerRequest) ProtoMessage() {}
-
-func (x *SellTowerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SellTowerRequest.ProtoReflect.Descriptor instead.
-func (*SellTowerRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *SellTowerRequest) GetTower() *TowerId {
-	if x != nil {
-		return x.Tower
-	}
-	return nil
-}
-
-type StartNewWaveRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *StartNewWaveRequest) Reset() {
-	*x = StartNewWaveRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *StartNewWaveRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StartNewWaveRequest) ProtoMessage() {}
-
-func (x *StartNewWaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StartNewWaveRequest.ProtoReflect.Descriptor instead.
-func (*StartNewWaveRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{12}
-}
-
-type SlowGameDownRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *SlowGameDownRequest) Reset() {
-	*x = SlowGameDownRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SlowGameDownRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SlowGameDownRequest) ProtoMessage() {}
-
-func (x *SlowGameDownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SlowGameDownRequest.ProtoReflect.Descriptor instead.
-func (*SlowGameDownRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{13}
-}
-
-type SpeedGameUpRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *SpeedGameUpRequest) Reset() {
-	*x = SpeedGameUpRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SpeedGameUpRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SpeedGameUpRequest) ProtoMessage() {}
-
-func (x *SpeedGameUpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SpeedGameUpRequest.ProtoReflect.Descriptor instead.
-func (*SpeedGameUpRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{14}
-}
-
 type LeaveLobbyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -802,7 +388,7 @@ type LeaveLobbyRequest struct {
 func (x *LeaveLobbyRequest) Reset() {
 	*x = LeaveLobbyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[15]
+		mi := &file_client_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -815,7 +401,7 @@ func (x *LeaveLobbyRequest) String() string {
 func (*LeaveLobbyRequest) ProtoMessage() {}
 
 func (x *LeaveLobbyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[15]
+	mi := &file_client_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -828,35 +414,36 @@ func (x *LeaveLobbyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveLobbyRequest.ProtoReflect.Descriptor instead.
 func (*LeaveLobbyRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{15}
+	return file_client_proto_rawDescGZIP(), []int{7}
 }
 
-type TuneTowerRequest struct {
+// CommandRequest is a player's command on the game.
+type CommandRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tower *TowerId             `protobuf:"bytes,1,opt,name=tower,proto3" json:"tower,omitempty"`
-	Aim   TuneTowerRequest_Aim `protobuf:"varint,2,opt,name=aim,proto3,enum=td_game.coopstate.TuneTowerRequest_Aim" json:"aim,omitempty"`
+	// action is the command encoded the same way as in the replays.
+	Action []byte `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
 }
 
-func (x *TuneTowerRequest) Reset() {
-	*x = TuneTowerRequest{}
+func (x *CommandRequest) Reset() {
+	*x = CommandRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[16]
+		mi := &file_client_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TuneTowerRequest) String() string {
+func (x *CommandRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TuneTowerRequest) ProtoMessage() {}
+func (*CommandRequest) ProtoMessage() {}
 
-func (x *TuneTowerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[16]
+func (x *CommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,25 +454,18 @@ func (x *TuneTowerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TuneTowerRequest.ProtoReflect.Descriptor instead.
-func (*TuneTowerRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CommandRequest.ProtoReflect.Descriptor instead.
+func (*CommandRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *TuneTowerRequest) GetTower() *TowerId {
+func (x *CommandRequest) GetAction() []byte {
 	if x != nil {
-		return x.Tower
+		return x.Action
 	}
 	return nil
 }
 
-func (x *TuneTowerRequest) GetAim() TuneTowerRequest_Aim {
-	if x != nil {
-		return x.Aim
-	}
-	return TuneTowerRequest_AIM_TOWER_AT_FIRST
-}
-
 var File_client_proto protoreflect.FileDescriptor
 
 var file_client_proto_rawDesc = []byte{
@@ -933,60 +513,17 @@ var file_client_proto_rawDesc = []byte{
 	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
 	0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74,
 	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x60, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x22, 0x47, 0x0a, 0x13, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x6f, 0x77,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x74, 0x6f, 0x77,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61,
-	0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x6f, 0x77,
-	0x65, 0x72, 0x49, 0x64, 0x52, 0x05, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x46, 0x0a, 0x12, 0x54,
-	0x75, 0x72, 0x6e, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x30, 0x0a, 0x05, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x52, 0x05, 0x74, 0x6f,
-	0x77, 0x65, 0x72, 0x22, 0x6f, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x77,
-	0x65, 0x72, 0x41, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x30, 0x0a, 0x05, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x52, 0x05, 0x74, 0x6f, 0x77,
-	0x65, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x41, 0x69, 0x6d,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x47, 0x0a, 0x13, 0x54, 0x75, 0x72, 0x6e, 0x54, 0x6f, 0x77, 0x65,
-	0x72, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x74,
-	0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x64, 0x5f,
-	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x54,
-	0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x52, 0x05, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x44, 0x0a,
-	0x10, 0x53, 0x65, 0x6c, 0x6c, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x30, 0x0a, 0x05, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x52, 0x05, 0x74, 0x6f,
-	0x77, 0x65, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x77, 0x57,
-	0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x6c,
-	0x6f, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x70, 0x65, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x55, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x76, 0x65,
-	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xce, 0x01, 0x0a,
-	0x10, 0x54, 0x75, 0x6e, 0x65, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x30, 0x0a, 0x05, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x52, 0x05, 0x74, 0x6f,
-	0x77, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x03, 0x61, 0x69, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x27, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x75, 0x6e, 0x65, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x69, 0x6d, 0x52, 0x03, 0x61, 0x69, 0x6d, 0x22, 0x4d,
-	0x0a, 0x03, 0x41, 0x69, 0x6d, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x49, 0x4d, 0x5f, 0x54, 0x4f, 0x57,
-	0x45, 0x52, 0x5f, 0x41, 0x54, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a,
-	0x13, 0x41, 0x49, 0x4d, 0x5f, 0x54, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x41, 0x54, 0x5f, 0x53, 0x54,
-	0x52, 0x4f, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x49, 0x4d, 0x5f, 0x54, 0x4f,
-	0x57, 0x45, 0x52, 0x5f, 0x41, 0x54, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0x02, 0x42, 0x2f, 0x5a,
-	0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x70, 0x68,
-	0x65, 0x72, 0x2d, 0x63, 0x6f, 0x2f, 0x74, 0x64, 0x2d, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2f, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x2a, 0x0a, 0x12, 0x53, 0x70, 0x65, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x13, 0x0a, 0x11,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x28, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x2f, 0x5a, 0x2d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x2d, 0x63, 0x6f, 0x2f, 0x74, 0x64, 0x2d, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2f, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1001,60 +538,40 @@ func file_client_proto_rawDescGZIP() []byte {
 	return file_client_proto_rawDescData
 }
 
-var file_client_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_client_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_client_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_client_proto_goTypes = []interface{}{
-	(TuneTowerRequest_Aim)(0),         // 0: td_game.coopstate.TuneTowerRequest.Aim
-	(*FetchLevelsRequest)(nil),        // 1: td_game.coopstate.FetchLevelsRequest
-	(*CreateLobbyRequest)(nil),        // 2: td_game.coopstate.CreateLobbyRequest
-	(*FetchLobbiesRequest)(nil),       // 3: td_game.coopstate.FetchLobbiesRequest
-	(*JoinLobbyRequest)(nil),          // 4: td_game.coopstate.JoinLobbyRequest
-	(*AwaitGameRequest)(nil),          // 5: td_game.coopstate.AwaitGameRequest
-	(*SendGameStateResponse)(nil),     // 6: td_game.coopstate.SendGameStateResponse
-	(*PutTowerRequest)(nil),           // 7: td_game.coopstate.PutTowerRequest
-	(*UpgradeTowerRequest)(nil),       // 8: td_game.coopstate.UpgradeTowerRequest
-	(*TurnTowerOnRequest)(nil),        // 9: td_game.coopstate.TurnTowerOnRequest
-	(*ChangeTowerAimTypeRequest)(nil), // 10: td_game.coopstate.ChangeTowerAimTypeRequest
-	(*TurnTowerOffRequest)(nil),       // 11: td_game.coopstate.TurnTowerOffRequest
-	(*SellTowerRequest)(nil),          // 12: td_game.coopstate.SellTowerRequest
-	(*StartNewWaveRequest)(nil),       // 13: td_game.coopstate.StartNewWaveRequest
-	(*SlowGameDownRequest)(nil),       // 14: td_game.coopstate.SlowGameDownRequest
-	(*SpeedGameUpRequest)(nil),        // 15: td_game.coopstate.SpeedGameUpRequest
-	(*LeaveLobbyRequest)(nil),         // 16: td_game.coopstate.LeaveLobbyRequest
-	(*TuneTowerRequest)(nil),          // 17: td_game.coopstate.TuneTowerRequest
-	(*Player)(nil),                    // 18: td_game.coopstate.Player
-	(*LobbyId)(nil),                   // 19: td_game.coopstate.LobbyId
-	(*Vacancy)(nil),                   // 20: td_game.coopstate.Vacancy
-	(*LevelId)(nil),                   // 21: td_game.coopstate.LevelId
-	(*PlayerState)(nil),               // 22: td_game.coopstate.PlayerState
-	(*MapState)(nil),                  // 23: td_game.coopstate.MapState
-	(Status)(0),                       // 24: td_game.coopstate.Status
-	(*Point)(nil),                     // 25: td_game.coopstate.Point
-	(*TowerId)(nil),                   // 26: td_game.coopstate.TowerId
+	(*FetchLevelsRequest)(nil),    // 0: td_game.coopstate.FetchLevelsRequest
+	(*CreateLobbyRequest)(nil),    // 1: td_game.coopstate.CreateLobbyRequest
+	(*FetchLobbiesRequest)(nil),   // 2: td_game.coopstate.FetchLobbiesRequest
+	(*JoinLobbyRequest)(nil),      // 3: td_game.coopstate.JoinLobbyRequest
+	(*AwaitGameRequest)(nil),      // 4: td_game.coopstate.AwaitGameRequest
+	(*SendGameStateResponse)(nil), // 5: td_game.coopstate.SendGameStateResponse
+	(*SpeedGameUpRequest)(nil),    // 6: td_game.coopstate.SpeedGameUpRequest
+	(*LeaveLobbyRequest)(nil),     // 7: td_game.coopstate.LeaveLobbyRequest
+	(*CommandRequest)(nil),        // 8: td_game.coopstate.CommandRequest
+	(*Player)(nil),                // 9: td_game.coopstate.Player
+	(*LobbyId)(nil),               // 10: td_game.coopstate.LobbyId
+	(*Vacancy)(nil),               // 11: td_game.coopstate.Vacancy
+	(*LevelId)(nil),               // 12: td_game.coopstate.LevelId
+	(*PlayerState)(nil),           // 13: td_game.coopstate.PlayerState
+	(*MapState)(nil),              // 14: td_game.coopstate.MapState
+	(Status)(0),                   // 15: td_game.coopstate.Status
 }
 var file_client_proto_depIdxs = []int32{
-	18, // 0: td_game.coopstate.CreateLobbyRequest.player:type_name -> td_game.coopstate.Player
-	19, // 1: td_game.coopstate.CreateLobbyRequest.lobby:type_name -> td_game.coopstate.LobbyId
-	20, // 2: td_game.coopstate.CreateLobbyRequest.vacancy:type_name -> td_game.coopstate.Vacancy
-	21, // 3: td_game.coopstate.CreateLobbyRequest.chosen_level:type_name -> td_game.coopstate.LevelId
-	18, // 4: td_game.coopstate.JoinLobbyRequest.player:type_name -> td_game.coopstate.Player
-	19, // 5: td_game.coopstate.JoinLobbyRequest.lobby:type_name -> td_game.coopstate.LobbyId
-	22, // 6: td_game.coopstate.SendGameStateResponse.player_state:type_name -> td_game.coopstate.PlayerState
-	23, // 7: td_game.coopstate.SendGameStateResponse.map_state:type_name -> td_game.coopstate.MapState
-	24, // 8: td_game.coopstate.SendGameStateResponse.status:type_name -> td_game.coopstate.Status
-	25, // 9: td_game.coopstate.PutTowerRequest.point:type_name -> td_game.coopstate.Point
-	26, // 10: td_game.coopstate.UpgradeTowerRequest.tower:type_name -> td_game.coopstate.TowerId
-	26, // 11: td_game.coopstate.TurnTowerOnRequest.tower:type_name -> td_game.coopstate.TowerId
-	26, // 12: td_game.coopstate.ChangeTowerAimTypeRequest.tower:type_name -> td_game.coopstate.TowerId
-	26, // 13: td_game.coopstate.TurnTowerOffRequest.tower:type_name -> td_game.coopstate.TowerId
-	26, // 14: td_game.coopstate.SellTowerRequest.tower:type_name -> td_game.coopstate.TowerId
-	26, // 15: td_game.coopstate.TuneTowerRequest.tower:type_name -> td_game.coopstate.TowerId
-	0,  // 16: td_game.coopstate.TuneTowerRequest.aim:type_name -> td_game.coopstate.TuneTowerRequest.Aim
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	9,  // 0: td_game.coopstate.CreateLobbyRequest.player:type_name -> td_game.coopstate.Player
+	10, // 1: td_game.coopstate.CreateLobbyRequest.lobby:type_name -> td_game.coopstate.LobbyId
+	11, // 2: td_game.coopstate.CreateLobbyRequest.vacancy:type_name -> td_game.coopstate.Vacancy
+	12, // 3: td_game.coopstate.CreateLobbyRequest.chosen_level:type_name -> td_game.coopstate.LevelId
+	9,  // 4: td_game.coopstate.JoinLobbyRequest.player:type_name -> td_game.coopstate.Player
+	10, // 5: td_game.coopstate.JoinLobbyRequest.lobby:type_name -> td_game.coopstate.LobbyId
+	13, // 6: td_game.coopstate.SendGameStateResponse.player_state:type_name -> td_game.coopstate.PlayerState
+	14, // 7: td_game.coopstate.SendGameStateResponse.map_state:type_name -> td_game.coopstate.MapState
+	15, // 8: td_game.coopstate.SendGameStateResponse.status:type_name -> td_game.coopstate.Status
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_client_proto_init() }
@@ -1137,102 +654,6 @@ func file_client_proto_init() {
 			}
 		}
 		file_client_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutTowerRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_client_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpgradeTowerRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_client_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TurnTowerOnRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_client_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeTowerAimTypeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_client_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TurnTowerOffRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_client_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SellTowerRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_client_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartNewWaveRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_client_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SlowGameDownRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_client_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SpeedGameUpRequest); i {
 			case 0:
 				return &v.state
@@ -1244,7 +665,7 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_client_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LeaveLobbyRequest); i {
 			case 0:
 				return &v.state
@@ -1256,8 +677,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TuneTowerRequest); i {
+		file_client_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1274,14 +695,13 @@ func file_client_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_client_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   17,
+			NumEnums:      0,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_client_proto_goTypes,
 		DependencyIndexes: file_client_proto_depIdxs,
-		EnumInfos:         file_client_proto_enumTypes,
 		MessageInfos:      file_client_proto_msgTypes,
 	}.Build()
 	File_client_proto = out.File
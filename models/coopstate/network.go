@@ -0,0 +1,156 @@
+package coopstate
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/controller"
+	"github.com/gopher-co/td-game/models/gamecontext"
+	"github.com/gopher-co/td-game/models/gamestate"
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/replay"
+)
+
+// lag is the number of the confirmed ticks the player can be behind the host.
+// The game further behind catches up at once.
+const lag = controller.TPS / 2
+
+// Network is an input of the co-op game played in lockstep.
+// The actions of the local player are sent to the host, the host sends the turns
+// with the actions of all the players stamped with the ticks they are applied at.
+type Network struct {
+	cli GameHostClient
+
+	stream GameHost_JoinLobbyClient
+
+	ctx context.Context
+
+	ch <-chan *JoinLobbyResponse
+
+	// turns are the turns received from the host and not applied yet.
+	turns []*Turn
+
+	// confirmed is the tick the game can be advanced up to.
+	confirmed general.Frames
+}
+
+// NewNetwork creates a new entity of Network and starts receiving the turns from the stream.
+func NewNetwork(cli GameHostClient, stream GameHost_JoinLobbyClient) *Network {
+	ch := make(chan *JoinLobbyResponse)
+	go func() {
+		defer close(ch)
+		for {
+			v, err := stream.Recv()
+			if err != nil {
+				log.Println(err)
+				return
+			}
+			ch <- v
+		}
+	}()
+
+	return &Network{
+		cli:    cli,
+		stream: stream,
+		ctx:    context.Background(),
+		ch:     ch,
+	}
+}
+
+// New creates a new co-op game on the level.
+// The co-op games are played on the default difficulty with the seed shared by the host.
+func New(
+	ctx *gamecontext.GameContext,
+	level *config.Level,
+	seed uint64,
+	cli GameHostClient,
+	cli2 GameHost_JoinLobbyClient,
+) *gamestate.GameState {
+	gs := gamestate.New(ctx, level, config.DefaultDifficulty(), NewNetwork(cli, cli2))
+	gs.Coop = true
+	gs.Seed(seed)
+
+	return gs
+}
+
+// Send sends the command to the host.
+// The command is encoded the same way as in the replays.
+func (n *Network) Send(a replay.Action) {
+	b, err := json.Marshal(a)
+	if err != nil {
+		log.Println("couldn't encode the command:", err)
+		return
+	}
+
+	if _, err := n.cli.SendCommand(n.ctx, &CommandRequest{Action: b}); err != nil {
+		log.Println("couldn't send the command:", err)
+	}
+}
+
+// Ticks returns the number of the ticks the game can be advanced by on the frame.
+// The game isn't advanced past the confirmed tick; if it's too far behind, it catches up.
+func (n *Network) Ticks(c *controller.Controller, ticks int) int {
+	n.receive()
+
+	ahead := n.confirmed - c.Tick
+	return max(min(ahead, max(ticks, ahead-lag)), 0)
+}
+
+// Poll returns the actions of the turn stamped with the current tick of the game.
+// The time scale of the turn is set from the tick.
+func (n *Network) Poll(c *controller.Controller) []replay.Action {
+	n.receive()
+
+	var as []replay.Action
+	for len(n.turns) > 0 && general.Frames(n.turns[0].GetTick()) <= c.Tick {
+		t := n.turns[0]
+		n.turns = n.turns[1:]
+
+		c.Clock.Scale = max(int(t.GetScale()), 1)
+		for _, b := range t.GetCommands() {
+			var a replay.Action
+			if err := json.Unmarshal(b, &a); err != nil {
+				log.Println("couldn't decode the command:", err)
+				continue
+			}
+
+			a.F = c.Time
+			as = append(as, a)
+		}
+	}
+
+	return as
+}
+
+// receive takes the turns that have come from the host.
+func (n *Network) receive() {
+	for {
+		select {
+		case v, ok := <-n.ch:
+			if !ok {
+				return
+			}
+			if t := v.GetTurn(); t != nil {
+				n.turns = append(n.turns, t)
+				n.confirmed = general.Frames(t.GetTick()) + max(general.Frames(t.GetScale()), 1)
+			}
+		default:
+			return
+		}
+	}
+}
+
+// SetSpeed asks the host to set the time scale of the game for all the players.
+// The scale is changed at the tick of the turn the host sends it in.
+func (n *Network) SetSpeed(_ *controller.Controller, scale int) {
+	if _, err := n.cli.SpeedGameUp(n.ctx, &SpeedGameUpRequest{Scale: int32(scale)}); err != nil {
+		log.Println("couldn't change the speed:", err)
+	}
+}
+
+// Close closes the stream of the actions.
+func (n *Network) Close() {
+	_ = n.stream.CloseSend()
+}
@@ -2,14 +2,25 @@ package coopstate
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"math/rand/v2"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/controller"
+	"github.com/gopher-co/td-game/models/gamecontext"
+	"github.com/gopher-co/td-game/models/gamestate"
+	"github.com/gopher-co/td-game/replay"
 )
 
 // States represents a map of states.
@@ -22,28 +33,41 @@ type Conns map[string]struct{}
 type Server struct {
 	// id is the server ID.
 	id string
-	// once is a sync.Once.
+	// once starts the turns of the game when all the players have joined.
 	once sync.Once
+	// mu guards the players, the game, the commands and the scale.
+	mu sync.Mutex
 	// conns is a map of connections.
 	conns Conns
 	// states is a map of states.
 	states States
 	// levelName is the level name.
 	levelName string
+	// seed is the seed of the random numbers of the game shared by the players.
+	seed uint64
 	// size is the size of the server.
 	size int
-	// gamestate is the game state.
-	gamestate GameState
 
-	speedUp bool
+	// game is the host's copy of the game the commands are validated against.
+	// It's advanced by the turns the same way as the games of the players.
+	game *controller.Controller
+	// commands are the players' commands sent since the previous turn.
+	commands []controller.Command
+	// scale is the time scale of the game.
+	scale int32
 	// UnimplementedGameHostServer is an unimplemented game host server.
 	UnimplementedGameHostServer
 }
 
 // JoinLobby joins the lobby.
 func (s *Server) JoinLobby(in *JoinLobbyRequest, ss GameHost_JoinLobbyServer) error {
-	s.states[in.Player.Id.Nickname] = ss
-	if err := s.TakeNewConnection(in.Player.Id.Nickname, in.Lobby.Name); err != nil {
+	s.mu.Lock()
+	err := s.TakeNewConnection(in.Player.Id.Nickname, in.Lobby.Name)
+	if err == nil {
+		s.states[in.Player.Id.Nickname] = ss
+	}
+	s.mu.Unlock()
+	if err != nil {
 		return err
 	}
 
@@ -54,190 +78,167 @@ func (s *Server) JoinLobby(in *JoinLobbyRequest, ss GameHost_JoinLobbyServer) er
 }
 
 // AwaitGame awaits the game.
+// The host starts sending the turns when all the players have joined.
 func (s *Server) AwaitGame(ctx context.Context, _ *AwaitGameRequest) (*AwaitGameResponse, error) {
 	for {
 		select {
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		case <-time.After(time.Second):
-			if len(s.conns) == s.size {
-				return &AwaitGameResponse{Level: s.levelName}, nil
+			s.mu.Lock()
+			full := len(s.conns) == s.size
+			s.mu.Unlock()
+			if full {
+				s.once.Do(func() { go s.run() })
+				return &AwaitGameResponse{Level: s.levelName, Seed: s.seed}, nil
 			}
 		}
 	}
 }
 
-// NewServer creates a new server.
-func NewServer(levelName string, size int) (*grpc.Server, string) {
-	grpcServer := grpc.NewServer()
-	s := &Server{
-		id:        uuid.NewString()[:8],
-		conns:     make(Conns, size),
-		states:    make(States, size),
-		levelName: levelName,
-		size:      size,
-	}
-	log.Println(s.id)
-	RegisterGameHostServer(grpcServer, s)
-
-	return grpcServer, s.id
-}
-
-// TakeNewConnection takes a new connection.
-func (s *Server) TakeNewConnection(nick, id string) error {
-	if s.id != id {
-		return errors.New("id mismatch")
-	}
-
-	if _, ok := s.conns[nick]; ok {
-		return fmt.Errorf("nickname %s already taken", nick)
-	}
-
-	s.conns[nick] = struct{}{}
-
-	return nil
-}
+// run sends the turns of the game to the players TPS times a second
+// until none of the players is connected.
+func (s *Server) run() {
+	ticker := time.NewTicker(time.Second / controller.TPS)
+	defer ticker.Stop()
 
-// PutTower puts a tower.
-func (s *Server) PutTower(_ context.Context, r *PutTowerRequest) (*PutTowerResponse, error) {
-	var errs error
-	for _, state := range s.states {
-		if err := state.Send(&JoinLobbyResponse{Response: &JoinLobbyResponse_PutTower{r}}); err != nil {
-			errs = errors.Join(errs, err)
+	for range ticker.C {
+		if err := s.turn(); err != nil {
+			log.Println("the game is over:", err)
+			return
 		}
 	}
-
-	if errs != nil {
-		return nil, errs
-	}
-	return &PutTowerResponse{Status: Status_OK}, nil
 }
 
-// StartNewWave starts a new wave.
-func (s *Server) StartNewWave(_ context.Context, r *StartNewWaveRequest) (*StartNewWaveResponse, error) {
-	var errs error
-	for _, state := range s.states {
-		if err := state.Send(&JoinLobbyResponse{Response: &JoinLobbyResponse_StartNewWave{r}}); err != nil {
-			errs = errors.Join(errs, err)
+// turn stamps the commands sent since the previous turn and the scale of the game
+// with the tick of the turn and sends them to all the players.
+// The commands are validated on the host's copy of the game at the tick, the invalid ones are dropped.
+// Returns an error if no player has got the turn or the game has ended.
+func (s *Server) turn() error {
+	s.mu.Lock()
+	t := &Turn{Tick: int64(s.game.Tick), Scale: s.scale}
+	for _, cmd := range s.commands {
+		if err := s.game.Execute(cmd); err != nil {
+			log.Println("the command is dropped:", err)
+			continue
 		}
-	}
 
-	if errs != nil {
-		return nil, errs
-	}
-	return &StartNewWaveResponse{Status: Status_OK}, nil
-}
-
-func (s *Server) SpeedGameUp(_ context.Context, r *SpeedGameUpRequest) (*SpeedGameUpResponse, error) {
-	if s.speedUp {
-		return &SpeedGameUpResponse{Status: Status_OK}, nil
-	}
-	s.speedUp = true
-	var errs error
-	for _, state := range s.states {
-		if err := state.Send(&JoinLobbyResponse{Response: &JoinLobbyResponse_SpeedUp{}}); err != nil {
-			errs = errors.Join(errs, err)
+		b, err := json.Marshal(controller.Encode(s.game.Time, cmd))
+		if err != nil {
+			log.Println("couldn't encode the command:", err)
+			continue
 		}
+		t.Commands = append(t.Commands, b)
 	}
-
-	if errs != nil {
-		return nil, errs
-	}
-	return &SpeedGameUpResponse{Status: Status_OK}, nil
-}
-
-func (s *Server) SlowGameDown(_ context.Context, r *SlowGameDownRequest) (*SlowGameDownResponse, error) {
-	if !s.speedUp {
-		return &SlowGameDownResponse{Status: Status_OK}, nil
+	s.commands = nil
+	for range s.scale {
+		s.game.Update()
 	}
-	s.speedUp = false
-	var errs error
+	ended := s.game.Ended
+	states := make([]GameHost_JoinLobbyServer, 0, len(s.states))
 	for _, state := range s.states {
-		if err := state.Send(&JoinLobbyResponse{Response: &JoinLobbyResponse_SlowDown{}}); err != nil {
-			errs = errors.Join(errs, err)
-		}
+		states = append(states, state)
 	}
+	s.mu.Unlock()
 
-	if errs != nil {
-		return nil, errs
-	}
-	return &SlowGameDownResponse{Status: Status_OK}, nil
-}
-
-func (s *Server) UpgradeTower(_ context.Context, r *UpgradeTowerRequest) (*UpgradeTowerResponse, error) {
 	var errs error
-	for _, state := range s.states {
-		if err := state.Send(&JoinLobbyResponse{Response: &JoinLobbyResponse_UpgradeTower{r}}); err != nil {
+	sent := 0
+	for _, state := range states {
+		if err := state.Send(&JoinLobbyResponse{Response: &JoinLobbyResponse_Turn{t}}); err != nil {
 			errs = errors.Join(errs, err)
+			continue
 		}
+		sent++
 	}
 
-	if errs != nil {
-		return nil, errs
+	if sent == 0 {
+		return errors.Join(errors.New("no player is connected"), errs)
+	}
+	if ended {
+		return errors.New("the game has ended")
 	}
 
-	return &UpgradeTowerResponse{Status: Status_OK}, nil
+	return nil
 }
 
-func (s *Server) ChangeTowerAimType(_ context.Context, r *ChangeTowerAimTypeRequest) (*ChangeTowerAimTypeResponse, error) {
-	var errs error
-	for _, state := range s.states {
-		if err := state.Send(&JoinLobbyResponse{Response: &JoinLobbyResponse_TuneTower{TuneTower: &TuneTowerRequest{
-			Tower: r.Tower,
-			Aim:   TuneTowerRequest_Aim(r.NewAimType),
-		}}}); err != nil {
-			errs = errors.Join(errs, err)
-		}
+// NewServer creates a new server of the co-op game on the level.
+// The host plays its copy of the game with the configs of the context to validate the players' commands.
+func NewServer(ctx *gamecontext.GameContext, levelName string, size int) (*grpc.Server, string, error) {
+	level, ok := ctx.Levels[levelName]
+	if !ok {
+		return nil, "", fmt.Errorf("level %s doesn't exist", levelName)
 	}
 
-	if errs != nil {
-		return nil, errs
+	grpcServer := grpc.NewServer()
+	s := &Server{
+		id:        uuid.NewString()[:8],
+		conns:     make(Conns, size),
+		states:    make(States, size),
+		levelName: levelName,
+		seed:      rand.Uint64(),
+		size:      size,
+		game:      gamestate.NewController(ctx, level, config.DefaultDifficulty(), controller.NewLocal()),
+		scale:     1,
 	}
+	s.game.Seed(s.seed)
+	log.Println(s.id)
+	RegisterGameHostServer(grpcServer, s)
 
-	return &ChangeTowerAimTypeResponse{Status: Status_OK}, nil
+	return grpcServer, s.id, nil
 }
 
-func (s *Server) SellTower(_ context.Context, r *SellTowerRequest) (*SellTowerResponse, error) {
-	var errs error
-	for _, state := range s.states {
-		if err := state.Send(&JoinLobbyResponse{Response: &JoinLobbyResponse_SellTower{r}}); err != nil {
-			errs = errors.Join(errs, err)
-		}
+// TakeNewConnection takes a new connection.
+func (s *Server) TakeNewConnection(nick, id string) error {
+	if s.id != id {
+		return errors.New("id mismatch")
 	}
 
-	if errs != nil {
-		return nil, errs
+	if _, ok := s.conns[nick]; ok {
+		return fmt.Errorf("nickname %s already taken", nick)
 	}
 
-	return &SellTowerResponse{Status: Status_OK}, nil
+	s.conns[nick] = struct{}{}
+
+	return nil
 }
 
-func (s *Server) TurnTowerOn(_ context.Context, r *TurnTowerOnRequest) (*TurnTowerOnResponse, error) {
-	var errs error
-	for _, state := range s.states {
-		if err := state.Send(&JoinLobbyResponse{Response: &JoinLobbyResponse_TurnOn{r}}); err != nil {
-			errs = errors.Join(errs, err)
-		}
+// SpeedGameUp sets the time scale of the game from the next turn.
+// Only the scales of controller.Scales are accepted.
+func (s *Server) SpeedGameUp(_ context.Context, r *SpeedGameUpRequest) (*SpeedGameUpResponse, error) {
+	if !slices.Contains(controller.Scales, int(r.GetScale())) {
+		return nil, status.Errorf(codes.InvalidArgument, "scale %d isn't one of %v", r.GetScale(), controller.Scales)
 	}
 
-	if errs != nil {
-		return nil, errs
-	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	return &TurnTowerOnResponse{Status: Status_OK}, nil
+	s.scale = r.GetScale()
+
+	return &SpeedGameUpResponse{Status: Status_OK}, nil
 }
 
-func (s *Server) TurnTowerOff(_ context.Context, r *TurnTowerOffRequest) (*TurnTowerOffResponse, error) {
-	var errs error
-	for _, state := range s.states {
-		if err := state.Send(&JoinLobbyResponse{Response: &JoinLobbyResponse_TurnOff{r}}); err != nil {
-			errs = errors.Join(errs, err)
-		}
+// SendCommand adds the player's command to the next turn.
+// The command that can't be applied to the game now is rejected.
+func (s *Server) SendCommand(_ context.Context, r *CommandRequest) (*CommandResponse, error) {
+	var a replay.Action
+	if err := json.Unmarshal(r.Action, &a); err != nil {
+		return nil, fmt.Errorf("invalid command: %w", err)
 	}
+	cmd, err := controller.Decode(a)
+	if err != nil {
+		return nil, fmt.Errorf("invalid command: %w", err)
+	}
+	if _, ok := cmd.(controller.Stop); ok {
+		return nil, errors.New("invalid command: the co-op game can't be stopped by a player")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	if errs != nil {
-		return nil, errs
+	if err := cmd.Validate(s.game); err != nil {
+		return nil, fmt.Errorf("invalid command: %w", err)
 	}
+	s.commands = append(s.commands, cmd)
 
-	return &TurnTowerOffResponse{Status: Status_OK}, nil
+	return &CommandResponse{Status: Status_OK}, nil
 }
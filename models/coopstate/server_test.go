@@ -0,0 +1,136 @@
+package coopstate_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/controller"
+	"github.com/gopher-co/td-game/models/coopstate"
+	"github.com/gopher-co/td-game/models/gamecontext"
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+// newHost starts the host of the game on the test level and returns its client.
+// The host is stopped at the end of the test.
+func newHost(t *testing.T) coopstate.GameHostClient {
+	t.Helper()
+	ctx := &gamecontext.GameContext{
+		Maps: map[string]*config.Map{
+			"Line": {Name: "Line", Path: []general.Point{{X: 0, Y: 540}, {X: 1500, Y: 540}}},
+		},
+		Levels: map[string]*config.Level{
+			"Test": {
+				LevelName: "Test",
+				MapName:   "Line",
+				GameRule:  config.GameRule{{Swarms: []config.EnemySwarm{{EnemyName: "#ff0000", Interval: 30, MaxCalls: 5}}}},
+			},
+		},
+		Towers: map[string]*config.Tower{
+			"Gun": {Name: "Gun", Price: 50, InitDamage: 3, InitRadius: 300, InitSpeedAttack: 15, InitProjectileVrms: 8},
+		},
+		Enemies: map[string]*config.Enemy{
+			"#ff0000": {Name: "#ff0000", MaxHealth: 20, Vrms: 2, Damage: 1, MoneyAward: 5},
+		},
+		PlayerState: &ingame.PlayerState{},
+	}
+
+	if _, _, err := coopstate.NewServer(ctx, "Unknown", 2); err == nil {
+		t.Error("the server of an unknown level is created")
+	}
+
+	s, _, err := coopstate.NewServer(ctx, "Test", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := bufconn.Listen(1 << 16)
+	go func() { _ = s.Serve(l) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return l.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return coopstate.NewGameHostClient(conn)
+}
+
+func TestSendCommand(t *testing.T) {
+	host := newHost(t)
+
+	tests := []struct {
+		name  string
+		cmd   controller.Command
+		valid bool
+	}{
+		{name: "put tower", cmd: controller.PutTower{Name: "Gun", Pos: general.Point{X: 700, Y: 450}}, valid: true},
+		{name: "start wave", cmd: controller.StartWave{Wave: 0}, valid: true},
+		{name: "unknown tower", cmd: controller.PutTower{Name: "Cannon", Pos: general.Point{X: 700, Y: 450}}},
+		{name: "tower on the path", cmd: controller.PutTower{Name: "Gun", Pos: general.Point{X: 700, Y: 540}}},
+		{name: "no tower to sell", cmd: controller.SellTower{Index: 0}},
+		{name: "stop", cmd: controller.Stop{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(controller.Encode(0, tt.cmd))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = host.SendCommand(context.Background(), &coopstate.CommandRequest{Action: b})
+			if (err == nil) != tt.valid {
+				t.Errorf("err = %v, want valid = %v", err, tt.valid)
+			}
+		})
+	}
+
+	malformed := []string{
+		`{`,
+		`{}`,
+		`null`,
+		`{"f":0,"type":0}`,
+		`{"f":0,"type":99,"info":{}}`,
+		`{"f":0,"type":0,"info":{"name":"Gun","x":"700"}}`,
+		`{"f":0,"type":0,"info":{"name":"Gun","x":700,"y":450,"z":1}}`,
+	}
+	for _, b := range malformed {
+		if _, err := host.SendCommand(context.Background(), &coopstate.CommandRequest{Action: []byte(b)}); err == nil {
+			t.Errorf("malformed command %s is accepted", b)
+		}
+	}
+
+	// the host survives the malformed commands
+	b, err := json.Marshal(controller.Encode(0, controller.PutTower{Name: "Gun", Pos: general.Point{X: 300, Y: 450}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := host.SendCommand(context.Background(), &coopstate.CommandRequest{Action: b}); err != nil {
+		t.Errorf("the host doesn't accept the commands after the malformed ones: %v", err)
+	}
+
+	for _, scale := range []int32{0, -1, 3, 1 << 30} {
+		_, err := host.SpeedGameUp(context.Background(), &coopstate.SpeedGameUpRequest{Scale: scale})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("scale %d: err = %v, want InvalidArgument", scale, err)
+		}
+	}
+	for _, scale := range controller.Scales {
+		if _, err := host.SpeedGameUp(context.Background(), &coopstate.SpeedGameUpRequest{Scale: int32(scale)}); err != nil {
+			t.Errorf("scale %d: %v", scale, err)
+		}
+	}
+}
@@ -26,7 +26,7 @@ var file_services_proto_rawDesc = []byte{
 	0x12, 0x11, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74,
 	0x61, 0x74, 0x65, 0x1a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32,
-	0xfa, 0x0b, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x0b,
+	0xce, 0x06, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x0b,
 	0x46, 0x65, 0x74, 0x63, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x64,
 	0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
 	0x46, 0x65, 0x74, 0x63, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
@@ -50,153 +50,82 @@ var file_services_proto_rawDesc = []byte{
 	0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x64,
 	0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
 	0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x08, 0x50, 0x75, 0x74, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x12,
-	0x22, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f,
-	0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x54, 0x6f, 0x77, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0c, 0x55, 0x70, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61,
-	0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x55, 0x70, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x6f, 0x77, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x54, 0x75, 0x72,
-	0x6e, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x12, 0x25, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61,
-	0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x75, 0x72,
-	0x6e, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x54, 0x75, 0x72, 0x6e, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0c, 0x54, 0x75, 0x72, 0x6e, 0x54,
-	0x6f, 0x77, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x12, 0x26, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d,
-	0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x75, 0x72, 0x6e,
-	0x54, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x54, 0x75, 0x72, 0x6e, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x66, 0x66,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x41, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c,
-	0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x41, 0x69,
-	0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x74,
-	0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x41, 0x69, 0x6d, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x09, 0x53,
-	0x65, 0x6c, 0x6c, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61,
-	0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x6c,
-	0x6c, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
-	0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x54, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x77, 0x57,
-	0x61, 0x76, 0x65, 0x12, 0x26, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f,
-	0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x77,
-	0x57, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x64,
+	0x65, 0x30, 0x01, 0x12, 0x5c, 0x0a, 0x0b, 0x53, 0x70, 0x65, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65,
+	0x55, 0x70, 0x12, 0x25, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f,
+	0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65,
+	0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x5f, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x70,
+	0x65, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x59, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12,
+	0x24, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b,
+	0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x21, 0x2e, 0x74, 0x64,
 	0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x65, 0x77, 0x57, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0c, 0x53, 0x6c, 0x6f, 0x77, 0x47, 0x61, 0x6d, 0x65,
-	0x44, 0x6f, 0x77, 0x6e, 0x12, 0x26, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63,
-	0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x6c, 0x6f, 0x77, 0x47, 0x61, 0x6d,
-	0x65, 0x44, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74,
-	0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x2e, 0x53, 0x6c, 0x6f, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x53, 0x70, 0x65, 0x65, 0x64, 0x47, 0x61,
-	0x6d, 0x65, 0x55, 0x70, 0x12, 0x25, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63,
-	0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x47, 0x61,
-	0x6d, 0x65, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64,
-	0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x53, 0x70, 0x65, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62,
-	0x79, 0x12, 0x24, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d,
-	0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
-	0x0a, 0x09, 0x41, 0x77, 0x61, 0x69, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x2e, 0x74, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x09, 0x41, 0x77, 0x61, 0x69, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x2e, 0x41, 0x77, 0x61, 0x69, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63,
+	0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x77, 0x61, 0x69, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0d, 0x53, 0x65,
+	0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x74, 0x64,
 	0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x41, 0x77, 0x61, 0x69, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x77, 0x61, 0x69, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x47, 0x61,
-	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d,
-	0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x28, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x2f, 0x5a, 0x2d,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x70, 0x68, 0x65,
-	0x72, 0x2d, 0x63, 0x6f, 0x2f, 0x74, 0x64, 0x2d, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2f, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x65, 0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x63,
+	0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
+	0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67,
+	0x6f, 0x70, 0x68, 0x65, 0x72, 0x2d, 0x63, 0x6f, 0x2f, 0x74, 0x64, 0x2d, 0x67, 0x61, 0x6d, 0x65,
+	0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x63, 0x6f, 0x6f, 0x70, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_services_proto_goTypes = []interface{}{
-	(*FetchLevelsRequest)(nil),         // 0: td_game.coopstate.FetchLevelsRequest
-	(*CreateLobbyRequest)(nil),         // 1: td_game.coopstate.CreateLobbyRequest
-	(*FetchLobbiesRequest)(nil),        // 2: td_game.coopstate.FetchLobbiesRequest
-	(*JoinLobbyRequest)(nil),           // 3: td_game.coopstate.JoinLobbyRequest
-	(*PutTowerRequest)(nil),            // 4: td_game.coopstate.PutTowerRequest
-	(*UpgradeTowerRequest)(nil),        // 5: td_game.coopstate.UpgradeTowerRequest
-	(*TurnTowerOnRequest)(nil),         // 6: td_game.coopstate.TurnTowerOnRequest
-	(*TurnTowerOffRequest)(nil),        // 7: td_game.coopstate.TurnTowerOffRequest
-	(*ChangeTowerAimTypeRequest)(nil),  // 8: td_game.coopstate.ChangeTowerAimTypeRequest
-	(*SellTowerRequest)(nil),           // 9: td_game.coopstate.SellTowerRequest
-	(*StartNewWaveRequest)(nil),        // 10: td_game.coopstate.StartNewWaveRequest
-	(*SlowGameDownRequest)(nil),        // 11: td_game.coopstate.SlowGameDownRequest
-	(*SpeedGameUpRequest)(nil),         // 12: td_game.coopstate.SpeedGameUpRequest
-	(*LeaveLobbyRequest)(nil),          // 13: td_game.coopstate.LeaveLobbyRequest
-	(*AwaitGameRequest)(nil),           // 14: td_game.coopstate.AwaitGameRequest
-	(*SendGameStateRequest)(nil),       // 15: td_game.coopstate.SendGameStateRequest
-	(*FetchLevelsResponse)(nil),        // 16: td_game.coopstate.FetchLevelsResponse
-	(*CreateLobbyResponse)(nil),        // 17: td_game.coopstate.CreateLobbyResponse
-	(*FetchLobbiesResponse)(nil),       // 18: td_game.coopstate.FetchLobbiesResponse
-	(*JoinLobbyResponse)(nil),          // 19: td_game.coopstate.JoinLobbyResponse
-	(*PutTowerResponse)(nil),           // 20: td_game.coopstate.PutTowerResponse
-	(*UpgradeTowerResponse)(nil),       // 21: td_game.coopstate.UpgradeTowerResponse
-	(*TurnTowerOnResponse)(nil),        // 22: td_game.coopstate.TurnTowerOnResponse
-	(*TurnTowerOffResponse)(nil),       // 23: td_game.coopstate.TurnTowerOffResponse
-	(*ChangeTowerAimTypeResponse)(nil), // 24: td_game.coopstate.ChangeTowerAimTypeResponse
-	(*SellTowerResponse)(nil),          // 25: td_game.coopstate.SellTowerResponse
-	(*StartNewWaveResponse)(nil),       // 26: td_game.coopstate.StartNewWaveResponse
-	(*SlowGameDownResponse)(nil),       // 27: td_game.coopstate.SlowGameDownResponse
-	(*SpeedGameUpResponse)(nil),        // 28: td_game.coopstate.SpeedGameUpResponse
-	(*LeaveLobbyResponse)(nil),         // 29: td_game.coopstate.LeaveLobbyResponse
-	(*AwaitGameResponse)(nil),          // 30: td_game.coopstate.AwaitGameResponse
-	(*SendGameStateResponse)(nil),      // 31: td_game.coopstate.SendGameStateResponse
+	(*FetchLevelsRequest)(nil),    // 0: td_game.coopstate.FetchLevelsRequest
+	(*CreateLobbyRequest)(nil),    // 1: td_game.coopstate.CreateLobbyRequest
+	(*FetchLobbiesRequest)(nil),   // 2: td_game.coopstate.FetchLobbiesRequest
+	(*JoinLobbyRequest)(nil),      // 3: td_game.coopstate.JoinLobbyRequest
+	(*SpeedGameUpRequest)(nil),    // 4: td_game.coopstate.SpeedGameUpRequest
+	(*LeaveLobbyRequest)(nil),     // 5: td_game.coopstate.LeaveLobbyRequest
+	(*CommandRequest)(nil),        // 6: td_game.coopstate.CommandRequest
+	(*AwaitGameRequest)(nil),      // 7: td_game.coopstate.AwaitGameRequest
+	(*SendGameStateRequest)(nil),  // 8: td_game.coopstate.SendGameStateRequest
+	(*FetchLevelsResponse)(nil),   // 9: td_game.coopstate.FetchLevelsResponse
+	(*CreateLobbyResponse)(nil),   // 10: td_game.coopstate.CreateLobbyResponse
+	(*FetchLobbiesResponse)(nil),  // 11: td_game.coopstate.FetchLobbiesResponse
+	(*JoinLobbyResponse)(nil),     // 12: td_game.coopstate.JoinLobbyResponse
+	(*SpeedGameUpResponse)(nil),   // 13: td_game.coopstate.SpeedGameUpResponse
+	(*LeaveLobbyResponse)(nil),    // 14: td_game.coopstate.LeaveLobbyResponse
+	(*CommandResponse)(nil),       // 15: td_game.coopstate.CommandResponse
+	(*AwaitGameResponse)(nil),     // 16: td_game.coopstate.AwaitGameResponse
+	(*SendGameStateResponse)(nil), // 17: td_game.coopstate.SendGameStateResponse
 }
 var file_services_proto_depIdxs = []int32{
 	0,  // 0: td_game.coopstate.GameHost.FetchLevels:input_type -> td_game.coopstate.FetchLevelsRequest
 	1,  // 1: td_game.coopstate.GameHost.CreateLobby:input_type -> td_game.coopstate.CreateLobbyRequest
 	2,  // 2: td_game.coopstate.GameHost.FetchLobbies:input_type -> td_game.coopstate.FetchLobbiesRequest
 	3,  // 3: td_game.coopstate.GameHost.JoinLobby:input_type -> td_game.coopstate.JoinLobbyRequest
-	4,  // 4: td_game.coopstate.GameHost.PutTower:input_type -> td_game.coopstate.PutTowerRequest
-	5,  // 5: td_game.coopstate.GameHost.UpgradeTower:input_type -> td_game.coopstate.UpgradeTowerRequest
-	6,  // 6: td_game.coopstate.GameHost.TurnTowerOn:input_type -> td_game.coopstate.TurnTowerOnRequest
-	7,  // 7: td_game.coopstate.GameHost.TurnTowerOff:input_type -> td_game.coopstate.TurnTowerOffRequest
-	8,  // 8: td_game.coopstate.GameHost.ChangeTowerAimType:input_type -> td_game.coopstate.ChangeTowerAimTypeRequest
-	9,  // 9: td_game.coopstate.GameHost.SellTower:input_type -> td_game.coopstate.SellTowerRequest
-	10, // 10: td_game.coopstate.GameHost.StartNewWave:input_type -> td_game.coopstate.StartNewWaveRequest
-	11, // 11: td_game.coopstate.GameHost.SlowGameDown:input_type -> td_game.coopstate.SlowGameDownRequest
-	12, // 12: td_game.coopstate.GameHost.SpeedGameUp:input_type -> td_game.coopstate.SpeedGameUpRequest
-	13, // 13: td_game.coopstate.GameHost.LeaveLobby:input_type -> td_game.coopstate.LeaveLobbyRequest
-	14, // 14: td_game.coopstate.GameHost.AwaitGame:input_type -> td_game.coopstate.AwaitGameRequest
-	15, // 15: td_game.coopstate.GameHost.SendGameState:input_type -> td_game.coopstate.SendGameStateRequest
-	16, // 16: td_game.coopstate.GameHost.FetchLevels:output_type -> td_game.coopstate.FetchLevelsResponse
-	17, // 17: td_game.coopstate.GameHost.CreateLobby:output_type -> td_game.coopstate.CreateLobbyResponse
-	18, // 18: td_game.coopstate.GameHost.FetchLobbies:output_type -> td_game.coopstate.FetchLobbiesResponse
-	19, // 19: td_game.coopstate.GameHost.JoinLobby:output_type -> td_game.coopstate.JoinLobbyResponse
-	20, // 20: td_game.coopstate.GameHost.PutTower:output_type -> td_game.coopstate.PutTowerResponse
-	21, // 21: td_game.coopstate.GameHost.UpgradeTower:output_type -> td_game.coopstate.UpgradeTowerResponse
-	22, // 22: td_game.coopstate.GameHost.TurnTowerOn:output_type -> td_game.coopstate.TurnTowerOnResponse
-	23, // 23: td_game.coopstate.GameHost.TurnTowerOff:output_type -> td_game.coopstate.TurnTowerOffResponse
-	24, // 24: td_game.coopstate.GameHost.ChangeTowerAimType:output_type -> td_game.coopstate.ChangeTowerAimTypeResponse
-	25, // 25: td_game.coopstate.GameHost.SellTower:output_type -> td_game.coopstate.SellTowerResponse
-	26, // 26: td_game.coopstate.GameHost.StartNewWave:output_type -> td_game.coopstate.StartNewWaveResponse
-	27, // 27: td_game.coopstate.GameHost.SlowGameDown:output_type -> td_game.coopstate.SlowGameDownResponse
-	28, // 28: td_game.coopstate.GameHost.SpeedGameUp:output_type -> td_game.coopstate.SpeedGameUpResponse
-	29, // 29: td_game.coopstate.GameHost.LeaveLobby:output_type -> td_game.coopstate.LeaveLobbyResponse
-	30, // 30: td_game.coopstate.GameHost.AwaitGame:output_type -> td_game.coopstate.AwaitGameResponse
-	31, // 31: td_game.coopstate.GameHost.SendGameState:output_type -> td_game.coopstate.SendGameStateResponse
-	16, // [16:32] is the sub-list for method output_type
-	0,  // [0:16] is the sub-list for method input_type
+	4,  // 4: td_game.coopstate.GameHost.SpeedGameUp:input_type -> td_game.coopstate.SpeedGameUpRequest
+	5,  // 5: td_game.coopstate.GameHost.LeaveLobby:input_type -> td_game.coopstate.LeaveLobbyRequest
+	6,  // 6: td_game.coopstate.GameHost.SendCommand:input_type -> td_game.coopstate.CommandRequest
+	7,  // 7: td_game.coopstate.GameHost.AwaitGame:input_type -> td_game.coopstate.AwaitGameRequest
+	8,  // 8: td_game.coopstate.GameHost.SendGameState:input_type -> td_game.coopstate.SendGameStateRequest
+	9,  // 9: td_game.coopstate.GameHost.FetchLevels:output_type -> td_game.coopstate.FetchLevelsResponse
+	10, // 10: td_game.coopstate.GameHost.CreateLobby:output_type -> td_game.coopstate.CreateLobbyResponse
+	11, // 11: td_game.coopstate.GameHost.FetchLobbies:output_type -> td_game.coopstate.FetchLobbiesResponse
+	12, // 12: td_game.coopstate.GameHost.JoinLobby:output_type -> td_game.coopstate.JoinLobbyResponse
+	13, // 13: td_game.coopstate.GameHost.SpeedGameUp:output_type -> td_game.coopstate.SpeedGameUpResponse
+	14, // 14: td_game.coopstate.GameHost.LeaveLobby:output_type -> td_game.coopstate.LeaveLobbyResponse
+	15, // 15: td_game.coopstate.GameHost.SendCommand:output_type -> td_game.coopstate.CommandResponse
+	16, // 16: td_game.coopstate.GameHost.AwaitGame:output_type -> td_game.coopstate.AwaitGameResponse
+	17, // 17: td_game.coopstate.GameHost.SendGameState:output_type -> td_game.coopstate.SendGameStateResponse
+	9,  // [9:18] is the sub-list for method output_type
+	0,  // [0:9] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
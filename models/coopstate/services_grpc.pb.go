@@ -19,22 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GameHost_FetchLevels_FullMethodName        = "/td_game.coopstate.GameHost/FetchLevels"
-	GameHost_CreateLobby_FullMethodName        = "/td_game.coopstate.GameHost/CreateLobby"
-	GameHost_FetchLobbies_FullMethodName       = "/td_game.coopstate.GameHost/FetchLobbies"
-	GameHost_JoinLobby_FullMethodName          = "/td_game.coopstate.GameHost/JoinLobby"
-	GameHost_PutTower_FullMethodName           = "/td_game.coopstate.GameHost/PutTower"
-	GameHost_UpgradeTower_FullMethodName       = "/td_game.coopstate.GameHost/UpgradeTower"
-	GameHost_TurnTowerOn_FullMethodName        = "/td_game.coopstate.GameHost/TurnTowerOn"
-	GameHost_TurnTowerOff_FullMethodName       = "/td_game.coopstate.GameHost/TurnTowerOff"
-	GameHost_ChangeTowerAimType_FullMethodName = "/td_game.coopstate.GameHost/ChangeTowerAimType"
-	GameHost_SellTower_FullMethodName          = "/td_game.coopstate.GameHost/SellTower"
-	GameHost_StartNewWave_FullMethodName       = "/td_game.coopstate.GameHost/StartNewWave"
-	GameHost_SlowGameDown_FullMethodName       = "/td_game.coopstate.GameHost/SlowGameDown"
-	GameHost_SpeedGameUp_FullMethodName        = "/td_game.coopstate.GameHost/SpeedGameUp"
-	GameHost_LeaveLobby_FullMethodName         = "/td_game.coopstate.GameHost/LeaveLobby"
-	GameHost_AwaitGame_FullMethodName          = "/td_game.coopstate.GameHost/AwaitGame"
-	GameHost_SendGameState_FullMethodName      = "/td_game.coopstate.GameHost/SendGameState"
+	GameHost_FetchLevels_FullMethodName   = "/td_game.coopstate.GameHost/FetchLevels"
+	GameHost_CreateLobby_FullMethodName   = "/td_game.coopstate.GameHost/CreateLobby"
+	GameHost_FetchLobbies_FullMethodName  = "/td_game.coopstate.GameHost/FetchLobbies"
+	GameHost_JoinLobby_FullMethodName     = "/td_game.coopstate.GameHost/JoinLobby"
+	GameHost_SpeedGameUp_FullMethodName   = "/td_game.coopstate.GameHost/SpeedGameUp"
+	GameHost_LeaveLobby_FullMethodName    = "/td_game.coopstate.GameHost/LeaveLobby"
+	GameHost_SendCommand_FullMethodName   = "/td_game.coopstate.GameHost/SendCommand"
+	GameHost_AwaitGame_FullMethodName     = "/td_game.coopstate.GameHost/AwaitGame"
+	GameHost_SendGameState_FullMethodName = "/td_game.coopstate.GameHost/SendGameState"
 )
 
 // GameHostClient is the client API for GameHost service.
@@ -45,16 +38,9 @@ type GameHostClient interface {
 	CreateLobby(ctx context.Context, in *CreateLobbyRequest, opts ...grpc.CallOption) (*CreateLobbyResponse, error)
 	FetchLobbies(ctx context.Context, in *FetchLobbiesRequest, opts ...grpc.CallOption) (*FetchLobbiesResponse, error)
 	JoinLobby(ctx context.Context, in *JoinLobbyRequest, opts ...grpc.CallOption) (GameHost_JoinLobbyClient, error)
-	PutTower(ctx context.Context, in *PutTowerRequest, opts ...grpc.CallOption) (*PutTowerResponse, error)
-	UpgradeTower(ctx context.Context, in *UpgradeTowerRequest, opts ...grpc.CallOption) (*UpgradeTowerResponse, error)
-	TurnTowerOn(ctx context.Context, in *TurnTowerOnRequest, opts ...grpc.CallOption) (*TurnTowerOnResponse, error)
-	TurnTowerOff(ctx context.Context, in *TurnTowerOffRequest, opts ...grpc.CallOption) (*TurnTowerOffResponse, error)
-	ChangeTowerAimType(ctx context.Context, in *ChangeTowerAimTypeRequest, opts ...grpc.CallOption) (*ChangeTowerAimTypeResponse, error)
-	SellTower(ctx context.Context, in *SellTowerRequest, opts ...grpc.CallOption) (*SellTowerResponse, error)
-	StartNewWave(ctx context.Context, in *StartNewWaveRequest, opts ...grpc.CallOption) (*StartNewWaveResponse, error)
-	SlowGameDown(ctx context.Context, in *SlowGameDownRequest, opts ...grpc.CallOption) (*SlowGameDownResponse, error)
 	SpeedGameUp(ctx context.Context, in *SpeedGameUpRequest, opts ...grpc.CallOption) (*SpeedGameUpResponse, error)
 	LeaveLobby(ctx context.Context, in *LeaveLobbyRequest, opts ...grpc.CallOption) (*LeaveLobbyResponse, error)
+	SendCommand(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error)
 	AwaitGame(ctx context.Context, in *AwaitGameRequest, opts ...grpc.CallOption) (*AwaitGameResponse, error)
 	SendGameState(ctx context.Context, in *SendGameStateRequest, opts ...grpc.CallOption) (GameHost_SendGameStateClient, error)
 }
@@ -126,78 +112,6 @@ func (x *gameHostJoinLobbyClient) Recv() (*JoinLobbyResponse, error) {
 	return m, nil
 }
 
-func (c *gameHostClient) PutTower(ctx context.Context, in *PutTowerRequest, opts ...grpc.CallOption) (*PutTowerResponse, error) {
-	out := new(PutTowerResponse)
-	err := c.cc.Invoke(ctx, GameHost_PutTower_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *gameHostClient) UpgradeTower(ctx context.Context, in *UpgradeTowerRequest, opts ...grpc.CallOption) (*UpgradeTowerResponse, error) {
-	out := new(UpgradeTowerResponse)
-	err := c.cc.Invoke(ctx, GameHost_UpgradeTower_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *gameHostClient) TurnTowerOn(ctx context.Context, in *TurnTowerOnRequest, opts ...grpc.CallOption) (*TurnTowerOnResponse, error) {
-	out := new(TurnTowerOnResponse)
-	err := c.cc.Invoke(ctx, GameHost_TurnTowerOn_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *gameHostClient) TurnTowerOff(ctx context.Context, in *TurnTowerOffRequest, opts ...grpc.CallOption) (*TurnTowerOffResponse, error) {
-	out := new(TurnTowerOffResponse)
-	err := c.cc.Invoke(ctx, GameHost_TurnTowerOff_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *gameHostClient) ChangeTowerAimType(ctx context.Context, in *ChangeTowerAimTypeRequest, opts ...grpc.CallOption) (*ChangeTowerAimTypeResponse, error) {
-	out := new(ChangeTowerAimTypeResponse)
-	err := c.cc.Invoke(ctx, GameHost_ChangeTowerAimType_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *gameHostClient) SellTower(ctx context.Context, in *SellTowerRequest, opts ...grpc.CallOption) (*SellTowerResponse, error) {
-	out := new(SellTowerResponse)
-	err := c.cc.Invoke(ctx, GameHost_SellTower_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *gameHostClient) StartNewWave(ctx context.Context, in *StartNewWaveRequest, opts ...grpc.CallOption) (*StartNewWaveResponse, error) {
-	out := new(StartNewWaveResponse)
-	err := c.cc.Invoke(ctx, GameHost_StartNewWave_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *gameHostClient) SlowGameDown(ctx context.Context, in *SlowGameDownRequest, opts ...grpc.CallOption) (*SlowGameDownResponse, error) {
-	out := new(SlowGameDownResponse)
-	err := c.cc.Invoke(ctx, GameHost_SlowGameDown_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
 func (c *gameHostClient) SpeedGameUp(ctx context.Context, in *SpeedGameUpRequest, opts ...grpc.CallOption) (*SpeedGameUpResponse, error) {
 	out := new(SpeedGameUpResponse)
 	err := c.cc.Invoke(ctx, GameHost_SpeedGameUp_FullMethodName, in, out, opts...)
@@ -216,6 +130,15 @@ func (c *gameHostClient) LeaveLobby(ctx context.Context, in *LeaveLobbyRequest,
 	return out, nil
 }
 
+func (c *gameHostClient) SendCommand(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, GameHost_SendCommand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gameHostClient) AwaitGame(ctx context.Context, in *AwaitGameRequest, opts ...grpc.CallOption) (*AwaitGameResponse, error) {
 	out := new(AwaitGameResponse)
 	err := c.cc.Invoke(ctx, GameHost_AwaitGame_FullMethodName, in, out, opts...)
@@ -265,16 +188,9 @@ type GameHostServer interface {
 	CreateLobby(context.Context, *CreateLobbyRequest) (*CreateLobbyResponse, error)
 	FetchLobbies(context.Context, *FetchLobbiesRequest) (*FetchLobbiesResponse, error)
 	JoinLobby(*JoinLobbyRequest, GameHost_JoinLobbyServer) error
-	PutTower(context.Context, *PutTowerRequest) (*PutTowerResponse, error)
-	UpgradeTower(context.Context, *UpgradeTowerRequest) (*UpgradeTowerResponse, error)
-	TurnTowerOn(context.Context, *TurnTowerOnRequest) (*TurnTowerOnResponse, error)
-	TurnTowerOff(context.Context, *TurnTowerOffRequest) (*TurnTowerOffResponse, error)
-	ChangeTowerAimType(context.Context, *ChangeTowerAimTypeRequest) (*ChangeTowerAimTypeResponse, error)
-	SellTower(context.Context, *SellTowerRequest) (*SellTowerResponse, error)
-	StartNewWave(context.Context, *StartNewWaveRequest) (*StartNewWaveResponse, error)
-	SlowGameDown(context.Context, *SlowGameDownRequest) (*SlowGameDownResponse, error)
 	SpeedGameUp(context.Context, *SpeedGameUpRequest) (*SpeedGameUpResponse, error)
 	LeaveLobby(context.Context, *LeaveLobbyRequest) (*LeaveLobbyResponse, error)
+	SendCommand(context.Context, *CommandRequest) (*CommandResponse, error)
 	AwaitGame(context.Context, *AwaitGameRequest) (*AwaitGameResponse, error)
 	SendGameState(*SendGameStateRequest, GameHost_SendGameStateServer) error
 	mustEmbedUnimplementedGameHostServer()
@@ -296,36 +212,15 @@ func (UnimplementedGameHostServer) FetchLobbies(context.Context, *FetchLobbiesRe
 func (UnimplementedGameHostServer) JoinLobby(*JoinLobbyRequest, GameHost_JoinLobbyServer) error {
 	return status.Errorf(codes.Unimplemented, "method JoinLobby not implemented")
 }
-func (UnimplementedGameHostServer) PutTower(context.Context, *PutTowerRequest) (*PutTowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PutTower not implemented")
-}
-func (UnimplementedGameHostServer) UpgradeTower(context.Context, *UpgradeTowerRequest) (*UpgradeTowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpgradeTower not implemented")
-}
-func (UnimplementedGameHostServer) TurnTowerOn(context.Context, *TurnTowerOnRequest) (*TurnTowerOnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TurnTowerOn not implemented")
-}
-func (UnimplementedGameHostServer) TurnTowerOff(context.Context, *TurnTowerOffRequest) (*TurnTowerOffResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TurnTowerOff not implemented")
-}
-func (UnimplementedGameHostServer) ChangeTowerAimType(context.Context, *ChangeTowerAimTypeRequest) (*ChangeTowerAimTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeTowerAimType not implemented")
-}
-func (UnimplementedGameHostServer) SellTower(context.Context, *SellTowerRequest) (*SellTowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SellTower not implemented")
-}
-func (UnimplementedGameHostServer) StartNewWave(context.Context, *StartNewWaveRequest) (*StartNewWaveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartNewWave not implemented")
-}
-func (UnimplementedGameHostServer) SlowGameDown(context.Context, *SlowGameDownRequest) (*SlowGameDownResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SlowGameDown not implemented")
-}
 func (UnimplementedGameHostServer) SpeedGameUp(context.Context, *SpeedGameUpRequest) (*SpeedGameUpResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SpeedGameUp not implemented")
 }
 func (UnimplementedGameHostServer) LeaveLobby(context.Context, *LeaveLobbyRequest) (*LeaveLobbyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveLobby not implemented")
 }
+func (UnimplementedGameHostServer) SendCommand(context.Context, *CommandRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCommand not implemented")
+}
 func (UnimplementedGameHostServer) AwaitGame(context.Context, *AwaitGameRequest) (*AwaitGameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AwaitGame not implemented")
 }
@@ -420,182 +315,56 @@ func (x *gameHostJoinLobbyServer) Send(m *JoinLobbyResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GameHost_PutTower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutTowerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GameHostServer).PutTower(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GameHost_PutTower_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameHostServer).PutTower(ctx, req.(*PutTowerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _GameHost_UpgradeTower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpgradeTowerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GameHostServer).UpgradeTower(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GameHost_UpgradeTower_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameHostServer).UpgradeTower(ctx, req.(*UpgradeTowerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _GameHost_TurnTowerOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TurnTowerOnRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GameHostServer).TurnTowerOn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GameHost_TurnTowerOn_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameHostServer).TurnTowerOn(ctx, req.(*TurnTowerOnRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _GameHost_TurnTowerOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TurnTowerOffRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GameHostServer).TurnTowerOff(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GameHost_TurnTowerOff_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameHostServer).TurnTowerOff(ctx, req.(*TurnTowerOffRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _GameHost_ChangeTowerAimType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeTowerAimTypeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GameHostServer).ChangeTowerAimType(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GameHost_ChangeTowerAimType_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameHostServer).ChangeTowerAimType(ctx, req.(*ChangeTowerAimTypeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _GameHost_SellTower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SellTowerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GameHostServer).SellTower(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GameHost_SellTower_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameHostServer).SellTower(ctx, req.(*SellTowerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _GameHost_StartNewWave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartNewWaveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GameHostServer).StartNewWave(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GameHost_StartNewWave_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameHostServer).StartNewWave(ctx, req.(*StartNewWaveRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _GameHost_SlowGameDown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SlowGameDownRequest)
+func _GameHost_SpeedGameUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpeedGameUpRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameHostServer).SlowGameDown(ctx, in)
+		return srv.(GameHostServer).SpeedGameUp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GameHost_SlowGameDown_FullMethodName,
+		FullMethod: GameHost_SpeedGameUp_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameHostServer).SlowGameDown(ctx, req.(*SlowGameDownRequest))
+		return srv.(GameHostServer).SpeedGameUp(ctx, req.(*SpeedGameUpRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameHost_SpeedGameUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SpeedGameUpRequest)
+func _GameHost_LeaveLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveLobbyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameHostServer).SpeedGameUp(ctx, in)
+		return srv.(GameHostServer).LeaveLobby(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GameHost_SpeedGameUp_FullMethodName,
+		FullMethod: GameHost_LeaveLobby_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameHostServer).SpeedGameUp(ctx, req.(*SpeedGameUpRequest))
+		return srv.(GameHostServer).LeaveLobby(ctx, req.(*LeaveLobbyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameHost_LeaveLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaveLobbyRequest)
+func _GameHost_SendCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameHostServer).LeaveLobby(ctx, in)
+		return srv.(GameHostServer).SendCommand(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GameHost_LeaveLobby_FullMethodName,
+		FullMethod: GameHost_SendCommand_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameHostServer).LeaveLobby(ctx, req.(*LeaveLobbyRequest))
+		return srv.(GameHostServer).SendCommand(ctx, req.(*CommandRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -658,38 +427,6 @@ var GameHost_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FetchLobbies",
 			Handler:    _GameHost_FetchLobbies_Handler,
 		},
-		{
-			MethodName: "PutTower",
-			Handler:    _GameHost_PutTower_Handler,
-		},
-		{
-			MethodName: "UpgradeTower",
-			Handler:    _GameHost_UpgradeTower_Handler,
-		},
-		{
-			MethodName: "TurnTowerOn",
-			Handler:    _GameHost_TurnTowerOn_Handler,
-		},
-		{
-			MethodName: "TurnTowerOff",
-			Handler:    _GameHost_TurnTowerOff_Handler,
-		},
-		{
-			MethodName: "ChangeTowerAimType",
-			Handler:    _GameHost_ChangeTowerAimType_Handler,
-		},
-		{
-			MethodName: "SellTower",
-			Handler:    _GameHost_SellTower_Handler,
-		},
-		{
-			MethodName: "StartNewWave",
-			Handler:    _GameHost_StartNewWave_Handler,
-		},
-		{
-			MethodName: "SlowGameDown",
-			Handler:    _GameHost_SlowGameDown_Handler,
-		},
 		{
 			MethodName: "SpeedGameUp",
 			Handler:    _GameHost_SpeedGameUp_Handler,
@@ -698,6 +435,10 @@ var GameHost_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveLobby",
 			Handler:    _GameHost_LeaveLobby_Handler,
 		},
+		{
+			MethodName: "SendCommand",
+			Handler:    _GameHost_SendCommand_Handler,
+		},
 		{
 			MethodName: "AwaitGame",
 			Handler:    _GameHost_AwaitGame_Handler,
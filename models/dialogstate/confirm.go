@@ -0,0 +1,72 @@
+// Package dialogstate provides the dialogs shown over the other scenes.
+package dialogstate
+
+import (
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"golang.org/x/image/colornames"
+
+	"github.com/gopher-co/td-game/models/scene"
+)
+
+// Confirm is a dialog that asks the player to confirm the action.
+// It is popped with true if the action is confirmed and with false otherwise.
+type Confirm struct {
+	// UI is a UI of the dialog.
+	UI *ebitenui.UI
+
+	// answer is the answer of the player. It is nil until the player answers.
+	answer *bool
+}
+
+// NewConfirm creates a new entity of Confirm with the question.
+func NewConfirm(question string) *Confirm {
+	c := &Confirm{}
+	c.UI = c.loadUI(question)
+
+	return c
+}
+
+// Update updates the dialog.
+// The dialog is cancelled by the Escape key.
+func (c *Confirm) Update(m *scene.Manager) error {
+	c.UI.Update()
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		c.answer = new(bool)
+	}
+
+	if c.answer != nil {
+		m.Pop(*c.answer)
+	}
+
+	return nil
+}
+
+// Draw draws the dialog.
+func (c *Confirm) Draw(screen *ebiten.Image) {
+	c.UI.Draw(screen)
+}
+
+// loadUI loads the UI of the dialog.
+func (c *Confirm) loadUI(question string) *ebitenui.UI {
+	root, panel := newPanel(question)
+
+	buttons := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionHorizontal),
+			widget.RowLayoutOpts.Spacing(40),
+		)),
+		widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Position: widget.RowLayoutPositionCenter,
+		})),
+	)
+
+	yes, no := true, false
+	buttons.AddChild(newButton("Yes", colornames.Darkgreen, func() { c.answer = &yes }))
+	buttons.AddChild(newButton("No", colornames.Indianred, func() { c.answer = &no }))
+	panel.AddChild(buttons)
+
+	return &ebitenui.UI{Container: root}
+}
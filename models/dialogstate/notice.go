@@ -0,0 +1,82 @@
+package dialogstate
+
+import (
+	"image/color"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"golang.org/x/image/colornames"
+
+	"github.com/gopher-co/td-game/models/scene"
+	"github.com/gopher-co/td-game/ui/font"
+)
+
+// Notice is a dialog that tells the player something, e.g. the unlocked achievements.
+// It is popped with nil when the player closes it.
+type Notice struct {
+	// UI is a UI of the dialog.
+	UI *ebitenui.UI
+
+	// closed is true when the player closes the dialog.
+	closed bool
+}
+
+// NewNotice creates a new entity of Notice with the title and the lines of the message.
+func NewNotice(title string, lines []string) *Notice {
+	n := &Notice{}
+	n.UI = n.loadUI(title, lines)
+
+	return n
+}
+
+// Update updates the dialog.
+// The dialog is closed by the Escape and the Enter keys.
+func (n *Notice) Update(m *scene.Manager) error {
+	n.UI.Update()
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		n.closed = true
+	}
+
+	if n.closed {
+		m.Pop(nil)
+	}
+
+	return nil
+}
+
+// Draw draws the dialog.
+func (n *Notice) Draw(screen *ebiten.Image) {
+	n.UI.Draw(screen)
+}
+
+// loadUI loads the UI of the dialog.
+func (n *Notice) loadUI(title string, lines []string) *ebitenui.UI {
+	root, panel := newPanel(title)
+
+	message := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Spacing(10),
+		)),
+		widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Position: widget.RowLayoutPositionCenter,
+		})),
+	)
+	for _, line := range lines {
+		message.AddChild(widget.NewText(
+			widget.TextOpts.Text(line, font.TTF32, color.White),
+			widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Position: widget.RowLayoutPositionCenter,
+			})),
+		))
+	}
+	panel.AddChild(message)
+
+	ok := newButton("OK", colornames.Darkgreen, func() { n.closed = true })
+	ok.GetWidget().LayoutData = widget.RowLayoutData{Position: widget.RowLayoutPositionCenter}
+	panel.AddChild(ok)
+
+	return &ebitenui.UI{Container: root}
+}
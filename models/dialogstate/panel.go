@@ -0,0 +1,57 @@
+package dialogstate
+
+import (
+	"image/color"
+
+	image2 "github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"golang.org/x/image/colornames"
+
+	"github.com/gopher-co/td-game/ui/font"
+)
+
+// newPanel returns the translucent root of the dialog and the panel in its center.
+// The panel contains the title, the content of the dialog is added to it.
+func newPanel(title string) (root, panel *widget.Container) {
+	root = widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewAnchorLayout()),
+		widget.ContainerOpts.BackgroundImage(image2.NewNineSliceColor(color.RGBA{A: 0xa0})),
+	)
+
+	panel = widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Spacing(40),
+			widget.RowLayoutOpts.Padding(widget.Insets{Top: 40, Left: 60, Right: 60, Bottom: 40}),
+		)),
+		widget.ContainerOpts.BackgroundImage(image2.NewNineSliceColor(colornames.Darkslategray)),
+		widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+			HorizontalPosition: widget.AnchorLayoutPositionCenter,
+			VerticalPosition:   widget.AnchorLayoutPositionCenter,
+		})),
+	)
+	panel.AddChild(widget.NewText(
+		widget.TextOpts.Text(title, font.TTF48, color.White),
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Position: widget.RowLayoutPositionCenter,
+		})),
+	))
+	root.AddChild(panel)
+
+	return root, panel
+}
+
+// newButton returns the button of the dialog that calls the handler when clicked.
+func newButton(label string, clr color.Color, handler func()) *widget.Button {
+	return widget.NewButton(
+		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.MinSize(200, 0)),
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle: image2.NewNineSliceColor(clr),
+		}),
+		widget.ButtonOpts.TextPadding(widget.Insets{Top: 5, Left: 10, Right: 10, Bottom: 5}),
+		widget.ButtonOpts.Text(label, font.TTF64, &widget.ButtonTextColor{Idle: color.White}),
+		widget.ButtonOpts.ClickedHandler(func(_ *widget.ButtonClickedEventArgs) {
+			handler()
+		}),
+	)
+}
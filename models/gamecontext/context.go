@@ -0,0 +1,241 @@
+// Package gamecontext provides the context of the game shared by its scenes.
+package gamecontext
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+
+	"github.com/gopher-co/td-game/io"
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/controller"
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+	"github.com/gopher-co/td-game/replay"
+	"github.com/gopher-co/td-game/ui"
+)
+
+// GameContext is a struct that represents the context of the game:
+// the configs loaded at the start and the data of the player.
+//
+// It is created once and passed to the scenes, so the independent games
+// don't share any state and can coexist in one process.
+type GameContext struct {
+	// Maps is a map of the configs of the maps.
+	Maps map[string]*config.Map
+
+	// Levels is a map of the configs of the levels.
+	Levels map[string]*config.Level
+
+	// Towers is a map of the configs of the towers.
+	Towers map[string]*config.Tower
+
+	// Difficulties is a list of difficulties sorted by their order.
+	Difficulties []*config.Difficulty
+
+	// Enemies is a map of the configs of the enemies.
+	Enemies map[string]*config.Enemy
+
+	// Achievements is a list of achievements sorted by their order.
+	Achievements []*config.Achievement
+
+	// Widgets are the images of the UI.
+	Widgets general.Widgets
+
+	// Replays is a list of the replays of the player.
+	Replays []*replay.Watcher
+
+	// PlayerState is a state of the player.
+	PlayerState *ingame.PlayerState
+
+	// Leaderboard is a leaderboard of the player's runs.
+	Leaderboard ingame.Leaderboard
+
+	// AchievementProgress is a progress of the player's achievements.
+	AchievementProgress *ingame.Achievements
+
+	// Saved is the saved unfinished game or nil.
+	Saved *controller.Save
+}
+
+// Load loads the configs and the data of the player and validates them.
+// The saved game that can't be loaded is skipped.
+func Load() (*GameContext, error) {
+	ctx := &GameContext{
+		Maps:    make(map[string]*config.Map),
+		Levels:  make(map[string]*config.Level),
+		Towers:  make(map[string]*config.Tower),
+		Enemies: make(map[string]*config.Enemy),
+		Widgets: make(general.Widgets),
+	}
+
+	if err := ctx.loadConfigs(); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.loadWidgets(); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.loadPlayer(); err != nil {
+		return nil, err
+	}
+
+	return ctx, nil
+}
+
+// DifficultyByName returns the difficulty with the name.
+// If there is no such difficulty, returns the default one.
+func (ctx *GameContext) DifficultyByName(name string) *config.Difficulty {
+	for _, d := range ctx.Difficulties {
+		if d.Name == name {
+			return d
+		}
+	}
+
+	return config.DefaultDifficulty()
+}
+
+// loadConfigs loads the configs of the maps, the levels, the difficulties,
+// the enemies, the towers and the achievements.
+func (ctx *GameContext) loadConfigs() error {
+	mcfgs, err := io.LoadMapConfigs()
+	if err != nil {
+		return err
+	}
+
+	for k := range mcfgs {
+		ctx.Maps[mcfgs[k].Name] = &mcfgs[k]
+	}
+
+	lcfgs, err := io.LoadLevelConfigs()
+	if err != nil {
+		return err
+	}
+
+	for k := range lcfgs {
+		lcfgs[k].Order = k + 1
+		ctx.Levels[lcfgs[k].LevelName] = &lcfgs[k]
+	}
+
+	for _, l := range ctx.Levels {
+		if err := l.Valid(ctx.Maps); err != nil {
+			return fmt.Errorf("invalid level: %w", err)
+		}
+	}
+
+	dcfgs, err := io.LoadDifficultyConfigs()
+	if err != nil {
+		return err
+	}
+
+	for k := range dcfgs {
+		if err := dcfgs[k].Valid(); err != nil {
+			return fmt.Errorf("invalid difficulty: %w", err)
+		}
+		dcfgs[k].Order = k + 1
+		ctx.Difficulties = append(ctx.Difficulties, &dcfgs[k])
+	}
+
+	ecfgs, err := io.LoadEnemyConfigs()
+	if err != nil {
+		return err
+	}
+
+	for k := range ecfgs {
+		ctx.Enemies[ecfgs[k].Name] = &ecfgs[k]
+	}
+
+	for _, e := range ctx.Enemies {
+		if err := e.Valid(ctx.Enemies); err != nil {
+			return fmt.Errorf("invalid enemy: %w", err)
+		}
+	}
+
+	tcfgs, err := io.LoadTowerConfigs()
+	if err != nil {
+		return err
+	}
+
+	for k := range tcfgs {
+		if err := tcfgs[k].Valid(); err != nil {
+			return fmt.Errorf("invalid tower: %w", err)
+		}
+		ctx.Towers[tcfgs[k].Name] = &tcfgs[k]
+	}
+
+	acfgs, err := io.LoadAchievementConfigs()
+	if err != nil {
+		return err
+	}
+
+	for k := range acfgs {
+		if err := acfgs[k].Valid(ctx.Levels, ctx.Towers); err != nil {
+			return fmt.Errorf("invalid achievement: %w", err)
+		}
+		ctx.Achievements = append(ctx.Achievements, &acfgs[k])
+	}
+
+	return nil
+}
+
+// loadWidgets loads the images of the UI.
+func (ctx *GameContext) loadWidgets() error {
+	uicfg, err := io.LoadUIConfig()
+	if err != nil {
+		return err
+	}
+	uicfg.Colors[ui.MenuMainLogoImage] = "menu_logo"
+	uicfg.Colors[ui.MenuMainImage] = "menu_main"
+
+	for k, v := range uicfg.Colors {
+		ctx.Widgets[k], err = ui.InitImage(v)
+		if err != nil {
+			return fmt.Errorf("image not loaded %v:%v, error: %w", k, v, err)
+		}
+	}
+
+	return nil
+}
+
+// loadPlayer loads the replays, the stats, the leaderboard,
+// the achievements and the saved game of the player.
+func (ctx *GameContext) loadPlayer() error {
+	if err := os.Mkdir("Replays", 0o777); err != nil && !errors.Is(err, fs.ErrExist) {
+		return fmt.Errorf("replays system is broken: %w", err)
+	}
+
+	var err error
+	ctx.Replays, err = io.LoadReplays()
+	if err != nil {
+		return fmt.Errorf("replays not loaded: %w", err)
+	}
+
+	ctx.PlayerState, err = io.LoadStats()
+	if err != nil {
+		return fmt.Errorf("stats not loaded: %w", err)
+	}
+
+	if err := ctx.PlayerState.Valid(ctx.Levels); err != nil {
+		return fmt.Errorf("invalid player stats: %w", err)
+	}
+
+	ctx.Leaderboard, err = io.LoadLeaderboard()
+	if err != nil {
+		return fmt.Errorf("leaderboard not loaded: %w", err)
+	}
+
+	ctx.AchievementProgress, err = io.LoadAchievements()
+	if err != nil {
+		return fmt.Errorf("achievements not loaded: %w", err)
+	}
+
+	ctx.Saved, err = io.LoadSave()
+	if err != nil {
+		log.Println("Saved game not loaded:", err)
+	}
+
+	return nil
+}
@@ -3,45 +3,49 @@ package gamestate
 import (
 	image2 "github.com/ebitenui/ebitenui/image"
 	"github.com/ebitenui/ebitenui/widget"
-	"github.com/hajimehoshi/ebiten/v2"
 	"golang.org/x/image/colornames"
 
 	"github.com/gopher-co/td-game/models/config"
-	"github.com/gopher-co/td-game/replay"
+	"github.com/gopher-co/td-game/models/controller"
+	"github.com/gopher-co/td-game/models/ingame"
 )
 
 // handleSpeed handles the speed button click.
-func (s *GameState) handleSpeed(args *widget.ButtonClickedEventArgs) {
-	if s.speedUp {
-		ebiten.SetTPS(60)
-		s.speedUp = false
-		args.Button.Image = &widget.ButtonImage{
-			Idle: image2.NewNineSliceColor(colornames.Cornflowerblue),
-		}
-		return
-	}
-
-	ebiten.SetTPS(180)
-	s.speedUp = true
-	args.Button.Image = &widget.ButtonImage{
-		Idle: image2.NewNineSliceColor(colornames.Greenyellow),
-	}
+// The game goes to the next time scale.
+func (s *GameState) handleSpeed(_ *widget.ButtonClickedEventArgs) {
+	s.Input.SetSpeed(s.Controller, s.Clock.NextScale())
 }
 
 // handleStart handles the start button click.
 func (s *GameState) handleStart(args *widget.ButtonClickedEventArgs) {
 	b := args.Button
-	if !b.GetWidget().Disabled {
-		s.State = Running
-		s.CurrentWave++
+	if !b.GetWidget().Disabled && s.send(controller.StartWave{Wave: s.CurrentWave + 1}) {
 		b.GetWidget().Disabled = true
 	}
 }
 
+// handleUndo handles the undo button click.
+// It undoes the last placement, upgrade or sell of the build phase.
+func (s *GameState) handleUndo(_ *widget.ButtonClickedEventArgs) {
+	s.send(controller.Undo{Depth: s.UndoDepth()})
+}
+
 // handleMenu handles the menu button click.
+// The player is asked to confirm leaving the game, the co-op game is left at once.
+// The game is saved to be continued later.
 func (s *GameState) handleMenu(_ *widget.ButtonClickedEventArgs) {
-	s.setStateAfterEnd()
-	s.Ended = true
+	if s.Coop {
+		s.leave()
+		return
+	}
+
+	s.confirmQuit = true
+}
+
+// leave stops the game left through the menu.
+func (s *GameState) leave() {
+	s.quit = true
+	s.Stop()
 }
 
 // handleTowerTake handles the tower take button click.
@@ -53,13 +57,11 @@ func (s *GameState) handleTowerTake(v *config.Tower) func(eventArgs *widget.Butt
 	}
 }
 
-// handleUpgrade handles the upgrade button click.
-func (s *GameState) handleUpgrade(_ *widget.ButtonClickedEventArgs) {
-	s.upgradeTowerHandler(s.chosenTower)
-
-	s.Watcher.Append(s.Time, replay.UpgradeTower, replay.InfoUpgradeTower{
-		Index: s.findTowerIndex(s.chosenTower),
-	})
+// handleUpgrade returns the handler of the click on the button of the upgrade id.
+func (s *GameState) handleUpgrade(id string) func(*widget.ButtonClickedEventArgs) {
+	return func(_ *widget.ButtonClickedEventArgs) {
+		s.send(controller.UpgradeTower{Index: s.TowerIndex(s.chosenTower), Upgrade: id})
+	}
 }
 
 // handleTurning handles the turning button click.
@@ -67,64 +69,53 @@ func (s *GameState) handleTurning(args *widget.ButtonClickedEventArgs) {
 	btn := args.Button
 
 	if s.chosenTower.State.IsTurnedOn {
-		s.turnOffTowerHandler(s.chosenTower)
+		s.send(controller.TurnTower{Index: s.TowerIndex(s.chosenTower), On: false})
+
 		btn.Text().Label = "OFF"
 		btn.Image = &widget.ButtonImage{
 			Idle: image2.NewNineSliceColor(colornames.Indianred),
 		}
 
-		s.Watcher.Append(s.Time, replay.TurnOff, replay.InfoTurnOffTower{
-			Index: s.findTowerIndex(s.chosenTower),
-		})
-
 		return
 	}
 
-	s.turnOnTowerHandler(s.chosenTower)
+	s.send(controller.TurnTower{Index: s.TowerIndex(s.chosenTower), On: true})
+
 	btn.Text().Label = "ON"
 	btn.Image = &widget.ButtonImage{
 		Idle: image2.NewNineSliceColor(colornames.Lawngreen),
 	}
-
-	s.Watcher.Append(s.Time, replay.TurnOn, replay.InfoTurnOnTower{
-		Index: s.findTowerIndex(s.chosenTower),
-	})
 }
 
-// handleTuneFirst handles the tune first button click.
-func (s *GameState) handleTuneFirst(_ *widget.ButtonClickedEventArgs) {
-	s.tuneFirstTowerHandler(s.chosenTower)
-
-	s.Watcher.Append(s.Time, replay.TuneFirst, replay.InfoTuneFirst{
-		Index: s.findTowerIndex(s.chosenTower),
-	})
+// handleTune returns the handler of the tune button click.
+// The handler sets the chosen tower to aim at the enemy chosen by aim.
+func (s *GameState) handleTune(aim ingame.Aim) func(*widget.ButtonClickedEventArgs) {
+	return func(_ *widget.ButtonClickedEventArgs) {
+		s.send(controller.TuneTower{Index: s.TowerIndex(s.chosenTower), Aim: aim})
+	}
 }
 
-// handleTuneStrong handles the tune strong button click.
-func (s *GameState) handleTuneStrong(_ *widget.ButtonClickedEventArgs) {
-	s.tuneStrongTowerHandler(s.chosenTower)
-
-	s.Watcher.Append(s.Time, replay.TuneStrong, replay.InfoTuneStrong{
-		Index: s.findTowerIndex(s.chosenTower),
-	})
+// handleTowerAbility handles the ability button click.
+// It activates the ability of the chosen tower.
+func (s *GameState) handleTowerAbility(_ *widget.ButtonClickedEventArgs) {
+	s.send(controller.UseTowerAbility{Index: s.TowerIndex(s.chosenTower)})
 }
 
-// handleTuneWeak handles the tune weak button click.
-func (s *GameState) handleTuneWeak(_ *widget.ButtonClickedEventArgs) {
-	s.tuneWeakTowerHandler(s.chosenTower)
-
-	s.Watcher.Append(s.Time, replay.TuneWeak, replay.InfoTuneWeak{
-		Index: s.findTowerIndex(s.chosenTower),
-	})
+// handleAbility returns the handler of the click on the button of the player's ability a.
+// The handler takes the ability so that the next click on the map uses it.
+func (s *GameState) handleAbility(a *ingame.Ability) func(*widget.ButtonClickedEventArgs) {
+	return func(_ *widget.ButtonClickedEventArgs) {
+		if s.State == controller.Running && a.Ready() {
+			s.tookTower = nil
+			s.tookAbility = a
+		}
+	}
 }
 
 // handleSell handles the sell button click.
 func (s *GameState) handleSell(_ *widget.ButtonClickedEventArgs) {
-	s.sellTowerHandler(s.chosenTower)
-
-	s.Watcher.Append(s.Time, replay.SellTower, replay.InfoSellTower{
-		Index: s.findTowerIndex(s.chosenTower),
-	})
+	s.send(controller.SellTower{Index: s.TowerIndex(s.chosenTower)})
+	s.chosenTower = nil
 
 	s.showTowerMenu()
 }
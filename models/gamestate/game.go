@@ -4,7 +4,6 @@ import (
 	"image"
 	"image/color"
 	"log"
-	maps2 "maps"
 	"slices"
 	"time"
 
@@ -14,96 +13,155 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 
+	"github.com/gopher-co/td-game/io"
 	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/controller"
+	"github.com/gopher-co/td-game/models/dialogstate"
+	"github.com/gopher-co/td-game/models/gamecontext"
 	"github.com/gopher-co/td-game/models/general"
 	"github.com/gopher-co/td-game/models/ingame"
+	"github.com/gopher-co/td-game/models/scene"
 	"github.com/gopher-co/td-game/replay"
 	"github.com/gopher-co/td-game/ui/updater"
 )
 
-// CurrentState is an enum that represents the current state of the game.
-type CurrentState int
+// GameState is a struct that represents the state of the game played through the UI.
+//
+// The game is simulated by the controller, the actions of the player
+// are sent to the controller's input.
+type GameState struct {
+	*controller.Controller
 
-const (
-	// Running is the state when the game is running.
-	Running CurrentState = iota
+	// Difficulty is a name of the difficulty of the game.
+	Difficulty string
 
-	// Paused is the state when the game is paused.
-	Paused
+	// Coop is a flag that shows if the game is played in the co-op mode.
+	Coop bool
 
-	// NextWaveReady is the state when the next wave is ready.
-	NextWaveReady
-)
+	// Score is a score of the game computed at the end of the game.
+	Score int
 
-// GameState is a struct that represents the state of the game.
-type GameState struct {
-	// LevelName is a name of the level.
-	LevelName string
+	// Stars is a number of the stars awarded at the end of the won game.
+	Stars int
 
-	// Map is a map of the game.
-	Map *ingame.Map
+	// UI is a UI of the game.
+	UI *ebitenui.UI
 
-	// TowersToBuy is a map of towers that can be bought.
-	TowersToBuy map[string]*config.Tower
+	// tookTower is a tower that was taken from the right sidebar.
+	tookTower *config.Tower
 
-	// EnemyToCall is a map of enemies that can be called.
-	EnemyToCall map[string]*config.Enemy
+	// tookAbility is a player's ability that was taken to be aimed at the map.
+	tookAbility *ingame.Ability
 
-	// Ended is a flag that represents if the game is ended.
-	Ended bool
+	// chosenTower is a tower that was chosen from the map.
+	chosenTower *ingame.Tower
 
-	// Win is a flag that represents if the game is won.
-	Win bool
+	// PlayerState is a state of the player.
+	PlayerState *ingame.PlayerState
+
+	// uiUpdater is an updater of the UI.
+	uiUpdater *updater.Updater
 
-	// State is a current state of the game.
-	State CurrentState
+	// towerMenu is a menu of the towers to buy shown on the right sidebar.
+	towerMenu *widget.Container
 
-	// UI is a UI of the game.
-	UI *ebitenui.UI
+	// towerInfo is a menu of the chosen tower shown on the right sidebar.
+	towerInfo *widget.Container
 
-	// CurrentWave is a number of the current wave.
-	CurrentWave int
+	// level is a config of the level.
+	level *config.Level
 
-	// GameRule is a game rule of the game.
-	GameRule ingame.GameRule
+	// ReplayPath is a path to the saved replay of the game.
+	// It is empty until the replay is saved.
+	ReplayPath string
 
-	// Time is a time of the game.
-	Time general.Frames
+	// Saved is the last save of the unfinished game.
+	// It is nil if the game is finished or played in the co-op mode.
+	Saved *controller.Save
 
-	// PlayerMapState is a state of the player on the map.
-	PlayerMapState ingame.PlayerMapState
+	// quit is a flag that shows if the player has left the game through the menu.
+	quit bool
 
-	// tookTower is a tower that was taken from the right sidebar.
-	tookTower *config.Tower
+	// confirmQuit is a flag that shows if the player is asked to confirm leaving the game.
+	confirmQuit bool
+}
 
-	// chosenTower is a tower that was chosen from the map.
-	chosenTower *ingame.Tower
+// Result is a result of the game passed to the scene that started it.
+type Result struct {
+	// LevelName is a name of the level of the game.
+	LevelName string
+
+	// Difficulty is a name of the difficulty of the game.
+	Difficulty string
+
+	// Coop is a flag that shows if the game was played in the co-op mode.
+	Coop bool
+
+	// Win is a flag that shows if the game was won.
+	Win bool
+
+	// Score is a score of the game.
+	Score int
+
+	// Stars is a number of the stars awarded for the game.
+	Stars int
 
-	// speedUp is a flag that represents if the game is speeded up.
-	speedUp bool
+	// Wave is a number of the last wave reached in the game.
+	Wave int
 
-	// Watcher is a watcher of the game.
+	// Watcher is a replay of the game.
 	Watcher *replay.Watcher
 
-	// PlayerState is a state of the player.
-	PlayerState *ingame.PlayerState
+	// ReplayPath is a path to the saved replay of the game or empty.
+	ReplayPath string
 
-	// uiUpdater is an updater of the UI.
-	uiUpdater *updater.Updater
+	// Saved is the save of the game left through the menu or nil.
+	Saved *controller.Save
 }
 
-// New creates a new entity of GameState.
+// New creates a new entity of GameState on the level with the configs and the player of the context.
+// The enemies, the towers and the starting money are changed by the difficulty.
+// The actions of the player are sent to the input.
 func New(
+	ctx *gamecontext.GameContext,
 	level *config.Level,
-	maps map[string]*config.Map,
-	en map[string]*config.Enemy,
-	tw map[string]*config.Tower,
-	ps *ingame.PlayerState,
-	w general.Widgets,
+	difficulty *config.Difficulty,
+	input controller.Input,
 ) *GameState {
+	gs := &GameState{
+		Controller:  NewController(ctx, level, difficulty, input),
+		Difficulty:  difficulty.Name,
+		PlayerState: ctx.PlayerState,
+		uiUpdater:   new(updater.Updater),
+		level:       level,
+	}
+
+	gs.UI = gs.loadGameUI(ctx.Widgets)
+
+	return gs
+}
+
+// NewController creates the simulation of the game on the level without its UI,
+// the same one the GameState created by New plays.
+func NewController(
+	ctx *gamecontext.GameContext,
+	level *config.Level,
+	difficulty *config.Difficulty,
+	input controller.Input,
+) *controller.Controller {
+	ps := ctx.PlayerState
+	rules := level.LevelRules()
+	start := ingame.PlayerMapState{
+		Health: rules.StartHealth,
+		Money:  difficulty.Money(rules.StartMoney),
+	}
+
 	// remove all the unavailable towers
-	tw2 := maps2.Clone(tw)
+	tw2 := difficulty.Towers(ctx.Towers)
 	filter(tw2, func(s string, c *config.Tower) bool {
+		if !rules.TowerAllowed(s) {
+			return true
+		}
 		if c.OpenLevel == "" {
 			return false
 		}
@@ -112,43 +170,18 @@ func New(
 		return !ok
 	})
 
-	// creating gamestate from configs
-	gs := &GameState{
-		LevelName:   level.LevelName,
-		Map:         ingame.NewMap(maps[level.MapName]),
-		TowersToBuy: tw2,
-		EnemyToCall: en,
-		State:       NextWaveReady,
-		CurrentWave: -1,
-		GameRule:    ingame.NewGameRule(level.GameRule),
-		PlayerMapState: ingame.PlayerMapState{
-			Health: 100,
-			Money:  650,
-		},
-		Watcher: &replay.Watcher{
-			Name: level.LevelName,
-			InitPlayerMapState: ingame.PlayerMapState{
-				Health: 100,
-				Money:  650,
-			},
-			Actions: make([]replay.Action, 0, 2500),
-		},
-		PlayerState: ps,
-		uiUpdater:   new(updater.Updater),
-	}
-
-	gs.UI = gs.loadGameUI(w)
+	c := controller.New(level, ctx.Maps[level.MapName], tw2, difficulty.Enemies(ctx.Enemies), start, input)
+	c.LevelsComplete = ps.LevelsComplete
+	c.Watcher.Difficulty = difficulty.Name
 
-	return gs
+	return c
 }
 
 // Update updates the state of the game.
-func (s *GameState) Update() error {
+// The ended game pops itself with its Result.
+func (s *GameState) Update(m *scene.Manager) error {
 	if s.Ended {
-		return nil
-	}
-
-	if s.State == Paused {
+		s.finish(m)
 		return nil
 	}
 
@@ -160,72 +193,90 @@ func (s *GameState) Update() error {
 	// put tower on the map
 	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButton0) && s.tookTower != nil {
 		x, y := ebiten.CursorPosition()
-		t := s.putTowerHandler(s.tookTower, x, y)
-		if t != nil {
-			s.Watcher.Append(s.Time, replay.PutTower, replay.InfoPutTower{
-				Name: t.Name,
-				X:    int(t.State.Pos.X),
-				Y:    int(t.State.Pos.Y),
-			})
-		}
+		s.putTowerHandler(s.tookTower, x, y)
+	} else if inpututil.IsMouseButtonJustPressed(ebiten.MouseButton0) && s.tookAbility != nil {
+		x, y := ebiten.CursorPosition()
+		s.useAbilityHandler(s.tookAbility, x, y)
 	} else if inpututil.IsMouseButtonJustPressed(ebiten.MouseButton2) {
 		s.tookTower = nil
+		s.tookAbility = nil
 	}
 
 	s.UI.Update()
 	s.uiUpdater.Update()
 
-	if s.Ended {
+	if s.confirmQuit || !s.Coop && inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		s.confirmQuit = false
+		scene.Show(m, dialogstate.NewConfirm("Leave the game?"), func(yes bool) {
+			if yes {
+				s.leave()
+			}
+		})
 		return nil
 	}
 
-	if s.State == NextWaveReady {
-		if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
-			btn := s.UI.Container.Children()[0].(*widget.Container). // mapContainer
-											Children()[2].(*widget.Container). // speed
-											Children()[0].(*widget.Container). // buttonGroup
-											Children()[0].(*widget.Button)
-			btn.ClickedEvent.Fire(&widget.ButtonClickedEventArgs{Button: btn})
+	if !s.Ended && inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		// the start button is pressed if the next wave is ready, the speed button otherwise
+		i := 1
+		if s.State == controller.NextWaveReady {
+			i = 0
 		}
-		return nil
-	}
 
-	if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
 		btn := s.UI.Container.Children()[0].(*widget.Container). // mapContainer
 										Children()[2].(*widget.Container). // speed
 										Children()[0].(*widget.Container). // buttonGroup
-										Children()[1].(*widget.Button)
+										Children()[i].(*widget.Button)
 		btn.ClickedEvent.Fire(&widget.ButtonClickedEventArgs{Button: btn})
 	}
 
-	s.Map.Update()
+	if inpututil.IsKeyJustPressed(ebiten.KeyZ) && ebiten.IsKeyPressed(ebiten.KeyControl) {
+		s.handleUndo(nil)
+	}
 
-	if s.PlayerMapState.Dead() {
-		s.Ended = true
-		s.setStateAfterEnd()
-		return nil
+	// the co-op game can't be paused by one of the players
+	if !s.Coop && inpututil.IsKeyJustPressed(ebiten.KeyP) {
+		s.Clock.Paused = !s.Clock.Paused
+	}
+	if !s.Coop && inpututil.IsKeyJustPressed(ebiten.KeyPeriod) {
+		s.Clock.Step()
 	}
 
-	wave := s.GameRule[s.CurrentWave]
-	s.updateRunning(wave)
+	running := s.State == controller.Running
+	s.Advance()
 
-	if wave.Ended() && !s.Map.AreThereAliveEnemies() {
-		s.setStateAfterWave()
-		if s.CurrentWave == len(s.GameRule)-1 {
-			s.Ended = true
-			s.Win = true
-			s.setStateAfterEnd()
-		}
-		return nil
+	// autosave at the end of every wave
+	if running && s.State == controller.NextWaveReady && !s.Ended {
+		s.save()
+	}
+
+	// the chosen tower may be removed by undoing its placement
+	if s.chosenTower != nil && s.TowerIndex(s.chosenTower) == -1 {
+		s.chosenTower = nil
+		s.showTowerMenu()
+	}
+
+	if s.Ended {
+		s.finish(m)
 	}
 
-	s.Time++
 	return nil
 }
 
-// End returns true if the game is ended.
-func (s *GameState) End() bool {
-	return s.Ended
+// finish finishes the ended game and pops it with its Result.
+func (s *GameState) finish(m *scene.Manager) {
+	s.setStateAfterEnd()
+	m.Pop(Result{
+		LevelName:  s.LevelName,
+		Difficulty: s.Difficulty,
+		Coop:       s.Coop,
+		Win:        s.Win,
+		Score:      s.Score,
+		Stars:      s.Stars,
+		Wave:       s.CurrentWave + 1,
+		Watcher:    s.Watcher,
+		ReplayPath: s.ReplayPath,
+		Saved:      s.Saved,
+	})
 }
 
 // Draw draws the game on the screen.
@@ -235,59 +286,70 @@ func (s *GameState) Draw(screen *ebiten.Image) {
 	}
 
 	subScreen := screen.SubImage(image.Rect(0, 0, 1500, 1080))
-	s.Map.Draw(subScreen.(*ebiten.Image))
+	s.Map.Draw(subScreen.(*ebiten.Image), s.Clock.Alpha())
 
 	if s.tookTower != nil {
 		s.drawTookImageBeforeCursor(screen)
 	}
+	if s.tookAbility != nil {
+		s.drawTookAbilityBeforeCursor(screen)
+	}
 
 	s.UI.Draw(screen)
 }
 
-// setStateAfterWave sets the state after the wave.
-func (s *GameState) setStateAfterWave() {
-	s.State = NextWaveReady
-	s.Map.Enemies = []*ingame.Enemy{}
-	s.Map.Projectiles = []*ingame.Projectile{}
-}
+// save saves the unfinished game to be continued later.
+// The co-op games aren't saved.
+func (s *GameState) save() {
+	if s.Coop {
+		return
+	}
 
-// clear clears the game state.
-func (s *GameState) clear() {
-	ebiten.SetTPS(60)
+	saved, err := s.Save()
+	if err != nil {
+		log.Println("couldn't save the game:", err)
+		return
+	}
+
+	s.Saved = saved
+	if err := io.SaveGame(s.Saved); err != nil {
+		log.Println("couldn't save the game:", err)
+	}
 }
 
 // setStateAfterEnd sets the state after the end of the game.
+// The game left through the menu is saved, the save of the finished game is removed.
 func (s *GameState) setStateAfterEnd() {
-	s.clear()
+	s.Input.Close()
+
+	if s.quit {
+		s.save()
+	} else if !s.Coop {
+		s.Saved = nil
+		if err := io.RemoveSave(); err != nil {
+			log.Println(err)
+		}
+	}
 
-	// replay save
-	s.Watcher.Append(s.Time, replay.Stop, replay.InfoStop{Null: nil})
+	s.Score = ingame.Score{
+		Health:     s.PlayerMapState.Health,
+		Money:      s.PlayerMapState.Money,
+		Time:       s.Time,
+		TowersSold: s.TowersSold,
+	}.Points()
+	if s.Win {
+		s.Stars = s.level.StarsFor(s.Score)
+	}
 
+	// replay save
 	timestamp := time.Now().Truncate(0).Format("2006-01-02T15_04_05")
 	s.Watcher.Time = timestamp
-	if err := replay.Save("./Replays/replay_"+timestamp+".json", s.Watcher); err != nil {
+	path := "./Replays/replay_" + timestamp + ".json"
+	if err := replay.Save(path, s.Watcher); err != nil {
 		log.Println("couldn't save replay:", err)
 		return
 	}
-}
-
-// updateRunning updates the state of the game when it is running.
-func (s *GameState) updateRunning(wave *ingame.Wave) {
-	es := wave.CallEnemies()
-	for _, str := range es {
-		s.Map.Enemies = append(s.Map.Enemies, ingame.NewEnemy(s.EnemyToCall[str], s.Map.Path))
-	}
-
-	for _, e := range s.Map.Enemies {
-		if e.State.Dead {
-			if e.State.PassPath {
-				s.PlayerMapState.Health = max(s.PlayerMapState.Health-e.DealDamageToPlayer(), 0)
-			} else {
-				s.PlayerMapState.Money += e.MoneyAward
-				e.MoneyAward = 0
-			}
-		}
-	}
+	s.ReplayPath = path
 }
 
 // drawTookImageBeforeCursor draws the image of the tower that was taken from the right sidebar.
@@ -296,7 +358,7 @@ func (s *GameState) drawTookImageBeforeCursor(screen *ebiten.Image) {
 	cx, cy := ebiten.CursorPosition()
 	ix, iy := img.Bounds().Dx(), img.Bounds().Dy()
 
-	if !ingame.CheckCollisionPath(general.Point{X: general.Coord(cx), Y: general.Coord(cy)}, s.Map.Path) {
+	if s.Map.CanPlaceTower(general.Point{X: general.Coord(cx), Y: general.Coord(cy)}) {
 		vector.DrawFilledCircle(screen, float32(cx), float32(cy), s.tookTower.InitRadius, color.RGBA{A: 0x20}, false)
 	} else {
 		vector.DrawFilledCircle(screen, float32(cx), float32(cy), s.tookTower.InitRadius, color.RGBA{R: 0xff, A: 0x20}, false)
@@ -307,6 +369,12 @@ func (s *GameState) drawTookImageBeforeCursor(screen *ebiten.Image) {
 	screen.DrawImage(img, &ebiten.DrawImageOptions{GeoM: geom})
 }
 
+// drawTookAbilityBeforeCursor draws the area of the player's ability that was taken to be aimed.
+func (s *GameState) drawTookAbilityBeforeCursor(screen *ebiten.Image) {
+	cx, cy := ebiten.CursorPosition()
+	vector.StrokeCircle(screen, float32(cx), float32(cy), s.tookAbility.Radius, 3, color.RGBA{R: 0xff, A: 0x60}, true)
+}
+
 // filter filters the map m by the function f.
 func filter[K comparable, V any, M ~map[K]V](m M, f func(K, V) bool) {
 	for k, v := range m {
@@ -327,7 +395,7 @@ func (s *GameState) rightSidebarHandle() {
 
 		b := true
 		for _, t := range ts {
-			if b && t.IsClicked() {
+			if b && !t.Sold && t.IsClicked() {
 				t.Chosen = true
 				s.chosenTower = t
 				b = false
@@ -343,84 +411,27 @@ func (s *GameState) rightSidebarHandle() {
 	}
 }
 
-// putTowerHandler handles the putting of the tower.
-func (s *GameState) putTowerHandler(tt *config.Tower, x, y int) *ingame.Tower {
-	pos := general.Point{X: general.Coord(x), Y: general.Coord(y)}
-
-	if x < 1500 && s.PlayerMapState.Money >= tt.Price {
-		if t := ingame.NewTower(tt, pos, s.Map.Path); t != nil {
-			s.tookTower = nil
-			s.PlayerMapState.Money -= tt.Price
-			s.Map.Towers = append(s.Map.Towers, t)
-
-			return t
-		}
-	}
-	return nil
-}
-
-// sellTowerHandler handles the selling of the tower.
-func (s *GameState) sellTowerHandler(t *ingame.Tower) {
-	p := t.Price
-	for i := 0; i < t.UpgradesBought; i++ {
-		p += t.Upgrades[i].Price
+// send sends the command of the player to the input.
+// The command isn't sent if it can't be applied to the game now.
+func (s *GameState) send(cmd controller.Command) bool {
+	if cmd.Validate(s.Controller) != nil {
+		return false
 	}
 
-	p = p * 7 / 10
-	s.PlayerMapState.Money += p
-
-	t.Sold = true
-
-	s.Map.Towers = slices.DeleteFunc(s.Map.Towers, func(tower *ingame.Tower) bool {
-		return tower == s.chosenTower
-	})
-	s.chosenTower = nil
+	s.Input.Send(controller.Encode(s.Time, cmd))
+	return true
 }
 
-// upgradeTowerHandler handles the upgrading of the tower.
-func (s *GameState) upgradeTowerHandler(t *ingame.Tower) {
-	if !t.Upgrade(s.PlayerState.LevelsComplete) {
-		return
+// putTowerHandler handles the putting of the tower at the point (x, y).
+func (s *GameState) putTowerHandler(tt *config.Tower, x, y int) {
+	if s.send(controller.PutTower{Name: tt.Name, Pos: general.Point{X: general.Coord(x), Y: general.Coord(y)}}) {
+		s.tookTower = nil
 	}
-
-	price := t.Upgrades[t.UpgradesBought-1].Price
-	s.PlayerMapState.Money -= price
-}
-
-// turnOnTowerHandler handles the turning on of the tower.
-func (s *GameState) turnOnTowerHandler(t *ingame.Tower) {
-	t.State.IsTurnedOn = true
-}
-
-// turnOffTowerHandler handles the turning off of the tower.
-func (s *GameState) turnOffTowerHandler(t *ingame.Tower) {
-	t.State.IsTurnedOn = false
 }
 
-// tuneFirstTowerHandler handles the tuning of the tower.
-// It sets the tower to aim at the first enemy.
-func (s *GameState) tuneFirstTowerHandler(t *ingame.Tower) {
-	t.State.AimType = ingame.First
-}
-
-// tuneStrongTowerHandler handles the tuning of the tower.
-// It sets the tower to aim at the strongest enemy.
-func (s *GameState) tuneStrongTowerHandler(t *ingame.Tower) {
-	t.State.AimType = ingame.Strongest
-}
-
-// tuneWeakTowerHandler handles the tuning of the tower.
-// It sets the tower to aim at the weakest enemy.
-func (s *GameState) tuneWeakTowerHandler(t *ingame.Tower) {
-	t.State.AimType = ingame.Weakest
-}
-
-// findTowerIndex finds the index of the tower t in the map of towers.
-func (s *GameState) findTowerIndex(t *ingame.Tower) int {
-	for k, v := range s.Map.Towers {
-		if v == t {
-			return k
-		}
+// useAbilityHandler handles the use of the player's ability a aimed at the point (x, y).
+func (s *GameState) useAbilityHandler(a *ingame.Ability, x, y int) {
+	if s.send(controller.UseAbility{Name: a.Name, Target: general.Point{X: general.Coord(x), Y: general.Coord(y)}}) {
+		s.tookAbility = nil
 	}
-	return -1
 }
@@ -1,7 +1,6 @@
 package gamestate
 
 import (
-	"fmt"
 	"image/color"
 
 	"github.com/ebitenui/ebitenui"
@@ -9,6 +8,7 @@ import (
 	"github.com/ebitenui/ebitenui/widget"
 	"golang.org/x/image/colornames"
 
+	"github.com/gopher-co/td-game/models/controller"
 	"github.com/gopher-co/td-game/models/general"
 	"github.com/gopher-co/td-game/ui/font"
 )
@@ -62,11 +62,7 @@ func (s *GameState) loadMapContainer(_ general.Widgets) *widget.Container {
 	)
 
 	s.uiUpdater.Append(func() {
-		if s.CurrentWave < 0 || s.CurrentWave >= len(s.GameRule) {
-			waveText.Label = ""
-			return
-		}
-		waveText.Label = fmt.Sprintf("Wave: %d/%d", s.CurrentWave+1, len(s.GameRule))
+		waveText.Label = s.WaveLabel()
 	})
 
 	waveContainer.AddChild(waveText)
@@ -117,7 +113,7 @@ func (s *GameState) loadMapContainer(_ general.Widgets) *widget.Container {
 	)
 
 	s.uiUpdater.Append(func() {
-		if s.State != Running && !s.End() {
+		if s.State != controller.Running && !s.End() {
 			startButton.GetWidget().Disabled = false
 		}
 	})
@@ -126,34 +122,182 @@ func (s *GameState) loadMapContainer(_ general.Widgets) *widget.Container {
 		widget.ButtonOpts.Image(&widget.ButtonImage{
 			Idle: image2.NewNineSliceColor(colornames.Cornflowerblue),
 		}),
-		widget.ButtonOpts.Text(">>", font.TTF64, &widget.ButtonTextColor{Idle: color.White}),
+		widget.ButtonOpts.Text(s.Clock.String(), font.TTF64, &widget.ButtonTextColor{Idle: color.White}),
 		widget.ButtonOpts.ClickedHandler(s.handleSpeed),
 	)
 
+	// the speed may be changed by the co-op partner too
+	s.uiUpdater.Append(func() {
+		if label := s.Clock.String(); speedButton.Text().Label != label {
+			speedButton.Text().Label = label
+			speedButton.Image = speedImage(s.Clock.Scale)
+		}
+	})
+
+	undoButton := widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:     image2.NewNineSliceColor(colornames.Darkorange),
+			Disabled: image2.NewNineSliceColor(colornames.Dimgray),
+		}),
+		widget.ButtonOpts.Text("Undo", font.TTF64, &widget.ButtonTextColor{
+			Idle:     color.White,
+			Disabled: color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 180},
+		}),
+		widget.ButtonOpts.ClickedHandler(s.handleUndo),
+	)
+
+	s.uiUpdater.Append(func() {
+		undoButton.GetWidget().Disabled = s.State != controller.NextWaveReady || s.UndoDepth() == 0
+	})
+
 	buttonGroup.AddChild(startButton)
 	buttonGroup.AddChild(speedButton)
+	buttonGroup.AddChild(undoButton)
 
 	speedContainer.AddChild(buttonGroup)
 
 	mapContainer.AddChild(waveContainer)
 	mapContainer.AddChild(buttonContainer)
 	mapContainer.AddChild(speedContainer)
+	mapContainer.AddChild(s.loadAbilitiesContainer())
+	mapContainer.AddChild(s.loadBossContainer())
 
 	return mapContainer
 }
 
-var cMenu, cInfo *widget.Container
+// loadAbilitiesContainer loads a container that contains the buttons of the player's abilities.
+func (s *GameState) loadAbilitiesContainer() *widget.Container {
+	abilitiesContainer := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewAnchorLayout()),
+	)
+
+	buttonGroup := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionHorizontal),
+			widget.RowLayoutOpts.Spacing(10),
+		)),
+		widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+			HorizontalPosition: 0,
+			VerticalPosition:   0,
+			StretchHorizontal:  false,
+			StretchVertical:    false,
+		})),
+	)
+
+	for _, a := range s.Map.Abilities {
+		btn := widget.NewButton(
+			widget.ButtonOpts.Image(&widget.ButtonImage{
+				Idle:     image2.NewNineSliceColor(colornames.Darkorange),
+				Hover:    image2.NewNineSliceColor(colornames.Orange),
+				Disabled: image2.NewNineSliceColor(colornames.Dimgray),
+			}),
+			widget.ButtonOpts.TextPadding(widget.Insets{
+				Top:    5,
+				Left:   10,
+				Right:  10,
+				Bottom: 5,
+			}),
+			widget.ButtonOpts.Text(abilityLabel(a), font.TTF32, &widget.ButtonTextColor{
+				Idle:     color.White,
+				Disabled: color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 180},
+			}),
+			widget.ButtonOpts.ClickedHandler(s.handleAbility(a)),
+		)
+
+		s.uiUpdater.Append(func() {
+			btn.Text().Label = abilityLabel(a)
+			btn.GetWidget().Disabled = s.State != controller.Running || !a.Ready()
+		})
+
+		buttonGroup.AddChild(btn)
+	}
+
+	abilitiesContainer.AddChild(buttonGroup)
+
+	return abilitiesContainer
+}
+
+// loadBossContainer loads a container that contains the health bar of the boss.
+// The container is hidden if there is no boss on the map.
+func (s *GameState) loadBossContainer() *widget.Container {
+	bossContainer := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewAnchorLayout(
+			widget.AnchorLayoutOpts.Padding(widget.Insets{Top: 10}),
+		)),
+	)
+
+	bar := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Spacing(5),
+		)),
+		widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+			HorizontalPosition: widget.AnchorLayoutPositionCenter,
+			VerticalPosition:   0,
+			StretchHorizontal:  false,
+			StretchVertical:    false,
+		})),
+	)
+
+	name := widget.NewText(
+		widget.TextOpts.Text("", font.TTF32, color.White),
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Position: widget.RowLayoutPositionCenter,
+		})),
+	)
+
+	health := widget.NewProgressBar(
+		widget.ProgressBarOpts.WidgetOpts(widget.WidgetOpts.MinSize(800, 24)),
+		widget.ProgressBarOpts.Images(
+			&widget.ProgressBarImage{Idle: image2.NewNineSliceColor(color.RGBA{R: 0x30, G: 0x30, B: 0x30, A: 0xc0})},
+			&widget.ProgressBarImage{Idle: image2.NewNineSliceColor(colornames.Crimson)},
+		),
+		widget.ProgressBarOpts.Values(0, 1, 1),
+		widget.ProgressBarOpts.TrackPadding(widget.Insets{Top: 2, Left: 2, Right: 2, Bottom: 2}),
+	)
+
+	s.uiUpdater.Append(func() {
+		boss := s.Map.Boss()
+		if boss == nil {
+			bar.GetWidget().Visibility = widget.Visibility_Hide
+			return
+		}
+
+		bar.GetWidget().Visibility = widget.Visibility_Show
+		name.Label = boss.Name
+		if boss.Immune() {
+			name.Label += " (immune)"
+		}
+		health.Max = boss.MaxHealth
+		health.SetCurrent(boss.State.Health)
+	})
+
+	bar.AddChild(name)
+	bar.AddChild(health)
+	bossContainer.AddChild(bar)
+
+	return bossContainer
+}
 
 // showTowerMenu shows the tower menu.
 func (s *GameState) showTowerMenu() {
 	menu := s.UI.Container.Children()[1].(*widget.Container).Children()[2].(*widget.Container)
 	menu.RemoveChildren()
-	menu.AddChild(cMenu)
+	menu.AddChild(s.towerMenu)
 }
 
 // showTowerInfoMenu shows the tower info menu.
 func (s *GameState) showTowerInfoMenu() {
 	menu := s.UI.Container.Children()[1].(*widget.Container).Children()[2].(*widget.Container)
 	menu.RemoveChildren()
-	menu.AddChild(cInfo)
+	menu.AddChild(s.towerInfo)
+}
+
+// speedImage returns the image of the speed button for the time scale.
+func speedImage(scale int) *widget.ButtonImage {
+	if scale > 1 {
+		return &widget.ButtonImage{Idle: image2.NewNineSliceColor(colornames.Greenyellow)}
+	}
+
+	return &widget.ButtonImage{Idle: image2.NewNineSliceColor(colornames.Cornflowerblue)}
 }
@@ -173,8 +173,8 @@ func (s *GameState) loadTowerMenuContainer(widgets general.Widgets) *widget.Cont
 
 	scrollContainer := s.scrollCont(widgets)
 	menuTower := s.newTowerMenuUI(widgets)
-	cMenu = scrollContainer
-	cInfo = menuTower
+	s.towerMenu = scrollContainer
+	s.towerInfo = menuTower
 
 	menu.AddChild(scrollContainer)
 
@@ -3,11 +3,13 @@ package gamestate
 import (
 	"fmt"
 	"image/color"
+	"strings"
 
 	image2 "github.com/ebitenui/ebitenui/image"
 	"github.com/ebitenui/ebitenui/widget"
 	"golang.org/x/image/colornames"
 
+	"github.com/gopher-co/td-game/models/controller"
 	"github.com/gopher-co/td-game/models/general"
 	"github.com/gopher-co/td-game/models/ingame"
 	"github.com/gopher-co/td-game/ui/font"
@@ -18,20 +20,22 @@ func (s *GameState) newTowerMenuUI(widgets general.Widgets) *widget.Container {
 	root := widget.NewContainer(
 		widget.ContainerOpts.Layout(widget.NewGridLayout(
 			widget.GridLayoutOpts.Columns(1),
-			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, false, false, false}),
+			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, false, false, false, false}),
 		)),
 	)
 
-	cInfo = root
+	s.towerInfo = root
 
 	info := s.textContainer(widgets)
 	upgrades := s.upgradesContainer(widgets)
 	tuning := s.tuningContainer(widgets)
+	ability := s.abilityContainer(widgets)
 	sell := s.sellContainer(widgets)
 
 	root.AddChild(info)
 	root.AddChild(upgrades)
 	root.AddChild(tuning)
+	root.AddChild(ability)
 	root.AddChild(sell)
 
 	return root
@@ -42,7 +46,7 @@ func (s *GameState) textContainer(_ general.Widgets) *widget.Container {
 	root := widget.NewContainer(
 		widget.ContainerOpts.Layout(widget.NewGridLayout(
 			widget.GridLayoutOpts.Columns(1),
-			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false}),
+			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, false}),
 			widget.GridLayoutOpts.Padding(widget.Insets{
 				Top: 50,
 			}),
@@ -55,100 +59,191 @@ func (s *GameState) textContainer(_ general.Widgets) *widget.Container {
 		widget.TextOpts.Position(widget.TextPositionCenter, widget.TextPositionStart),
 	)
 
+	veterancy := widget.NewText(
+		widget.TextOpts.Text("", font.TTF20, color.White),
+		widget.TextOpts.MaxWidth(400),
+		widget.TextOpts.Position(widget.TextPositionCenter, widget.TextPositionStart),
+	)
+
 	s.uiUpdater.Append(func() {
 		if s.chosenTower == nil {
 			return
 		}
 		name.Label = s.chosenTower.Name
+		veterancy.Label = veterancyText(s.chosenTower)
 	})
 
 	root.AddChild(name)
+	root.AddChild(veterancy)
 
 	return root
 }
 
+// veterancyText returns the rank, the kills and the damage dealt of the tower.
+func veterancyText(t *ingame.Tower) string {
+	rank := "Recruit"
+	if r := t.Rank(); r >= 0 {
+		rank = t.Ranks[r].Name
+	}
+
+	return fmt.Sprintf("%s | Kills: %d | Damage: %d", rank, t.Kills, t.DamageDealt)
+}
+
+// upgradeColors contains the colors of the upgrades in the tree by their status.
+var upgradeColors = map[ingame.UpgradeStatus]string{
+	ingame.UpgradeBought:    "00FF00",
+	ingame.UpgradeAvailable: "FFFFFF",
+	ingame.UpgradeLater:     "AAAAAA",
+	ingame.UpgradeClosed:    "555555",
+}
+
 // upgradesContainer creates a container that contains the upgrades of the tower.
 func (s *GameState) upgradesContainer(_ general.Widgets) *widget.Container {
 	root := widget.NewContainer(
 		widget.ContainerOpts.Layout(widget.NewGridLayout(
 			widget.GridLayoutOpts.Columns(1),
-			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, false, false}),
+			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, false, false, false}),
 		)),
 	)
 
-	btn := widget.NewButton(
-		widget.ButtonOpts.Image(&widget.ButtonImage{
-			Idle:     image2.NewNineSliceColor(color.RGBA{R: 0x99, G: 0xe7, B: 0xa9, A: 0xff}),
-			Hover:    image2.NewNineSliceColor(color.RGBA{R: 0xa9, G: 0xee, B: 0xae, A: 0xff}),
-			Pressed:  image2.NewNineSliceColor(color.RGBA{R: 0x89, G: 0xd7, B: 0x99, A: 0xff}),
-			Disabled: image2.NewNineSliceColor(color.RGBA{R: 0x66, G: 0x05, B: 0x28, A: 0xff}),
-		}),
-		widget.ButtonOpts.Text("UPGRADE", font.TTF32, &widget.ButtonTextColor{
-			Idle:     color.White,
-			Disabled: color.Black,
-		}),
-		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.MinSize(0, 100)),
-		widget.ButtonOpts.ClickedHandler(s.handleUpgrade),
-	)
-
 	level := widget.NewText(
 		widget.TextOpts.Text("Level", font.TTF48, color.White),
 	)
 
+	tree := widget.NewText(
+		widget.TextOpts.Text("", font.TTF20, color.White),
+		widget.TextOpts.ProcessBBCode(true),
+		widget.TextOpts.MaxWidth(400),
+	)
+
+	// choices contains a button for each branch the player can choose
+	choices := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(1),
+			widget.GridLayoutOpts.Stretch([]bool{true}, nil),
+			widget.GridLayoutOpts.Spacing(0, 5),
+		)),
+	)
+
 	info := s.textUpgradeInfo()
 
+	var (
+		shown    *ingame.Tower
+		bought   int
+		buttons  []*widget.Button
+		selected *ingame.Upgrade
+	)
+
 	s.uiUpdater.Append(func() {
 		if s.chosenTower == nil {
 			return
 		}
 
-		level.Label = fmt.Sprintf("Level %d", s.chosenTower.UpgradesBought+1)
+		t := s.chosenTower
+		ups := t.AvailableUpgrades()
 
-		// all the upgrades are bought
-		if s.chosenTower.UpgradesBought >= len(s.chosenTower.Upgrades) {
-			c := info.Children()
-			insertValues(c[0].(*widget.Text), s.chosenTower.Damage, 0, "Damage")
-			insertValues(c[1].(*widget.Text), int(s.chosenTower.Radius), 0, "Radius")
-			insertValues(c[2].(*widget.Text), s.chosenTower.SpeedAttack, 0, "Speed")
-			insertValues(c[3].(*widget.Text), int(s.chosenTower.ProjectileVrms), 0, "ProjSpeed")
+		level.Label = fmt.Sprintf("Level %d", len(t.Bought)+1)
+		tree.Label = upgradeTreeText(t)
 
-			btn.Text().Label = "SOLD OUT"
-			btn.GetWidget().Disabled = true
+		// the buttons are recreated only when the choice changes
+		if shown != t || bought != len(t.Bought) {
+			shown, bought, selected = t, len(t.Bought), nil
 
-			return
+			choices.RemoveChildren()
+			buttons = buttons[:0]
+			for _, u := range ups {
+				btn := s.upgradeButton(u.ID, func() { selected = u })
+				buttons = append(buttons, btn)
+				choices.AddChild(btn)
+			}
+
+			// all the upgrades are bought
+			if len(ups) == 0 {
+				btn := s.upgradeButton("", nil)
+				btn.Text().Label = "SOLD OUT"
+				btn.GetWidget().Disabled = true
+				choices.AddChild(btn)
+			}
 		}
 
 		c := info.Children()
-		u := s.chosenTower.Upgrades[s.chosenTower.UpgradesBought]
-		insertValues(c[0].(*widget.Text), s.chosenTower.Damage, u.DeltaDamage, "Damage")
-		insertValues(c[1].(*widget.Text), int(s.chosenTower.Radius), int(u.DeltaRadius), "Radius")
-		insertValues(c[2].(*widget.Text), s.chosenTower.SpeedAttack, u.DeltaSpeedAttack, "Speed")
-		insertValues(c[3].(*widget.Text), int(s.chosenTower.ProjectileVrms), 0, "ProjSpeed")
+		if len(ups) == 0 {
+			insertValues(c[0].(*widget.Text), t.Damage, 0, "Damage")
+			insertValues(c[1].(*widget.Text), int(t.Radius), 0, "Radius")
+			insertValues(c[2].(*widget.Text), t.SpeedAttack, 0, "Speed")
+			insertValues(c[3].(*widget.Text), int(t.ProjectileVrms), 0, "ProjSpeed")
 
-		openLevel := s.chosenTower.Upgrades[s.chosenTower.UpgradesBought].OpenLevel
-		_, ok := s.PlayerState.LevelsComplete[openLevel]
+			return
+		}
 
-		if !ok && openLevel != "" {
-			btn.Text().Label = "Complete level to unlock:\n" + s.chosenTower.Upgrades[s.chosenTower.UpgradesBought].OpenLevel
-		} else {
-			btn.Text().Label = fmt.Sprintf("UPGRADE ($%d)", s.chosenTower.Upgrades[s.chosenTower.UpgradesBought].Price)
+		for i, u := range ups {
+			_, ok := s.PlayerState.LevelsComplete[u.OpenLevel]
+
+			if !ok && u.OpenLevel != "" {
+				buttons[i].Text().Label = u.Name + "\nComplete level to unlock:\n" + u.OpenLevel
+			} else {
+				buttons[i].Text().Label = fmt.Sprintf("%s ($%d)", u.Name, u.Price)
+			}
+
+			buttons[i].GetWidget().Disabled = s.PlayerMapState.Money < u.Price || !ok && u.OpenLevel != ""
 		}
 
-		if s.PlayerMapState.Money < s.chosenTower.Upgrades[s.chosenTower.UpgradesBought].Price ||
-			!ok && openLevel != "" {
-			btn.GetWidget().Disabled = true
-		} else {
-			btn.GetWidget().Disabled = false
+		// shows the effect of the upgrade under the cursor or of the first one
+		u := ups[0]
+		if selected != nil {
+			u = selected
 		}
+		insertValues(c[0].(*widget.Text), t.Damage, u.DeltaDamage, "Damage")
+		insertValues(c[1].(*widget.Text), int(t.Radius), int(u.DeltaRadius), "Radius")
+		insertValues(c[2].(*widget.Text), t.SpeedAttack, u.DeltaSpeedAttack, "Speed")
+		insertValues(c[3].(*widget.Text), int(t.ProjectileVrms), int(u.DeltaProjectileVrms), "ProjSpeed")
 	})
 
 	root.AddChild(level)
-	root.AddChild(btn)
+	root.AddChild(tree)
+	root.AddChild(choices)
 	root.AddChild(info)
 
 	return root
 }
 
+// upgradeButton creates a button that buys the upgrade id.
+// The hover function is called when the cursor enters the button.
+func (s *GameState) upgradeButton(id string, hover func()) *widget.Button {
+	return widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:     image2.NewNineSliceColor(color.RGBA{R: 0x99, G: 0xe7, B: 0xa9, A: 0xff}),
+			Hover:    image2.NewNineSliceColor(color.RGBA{R: 0xa9, G: 0xee, B: 0xae, A: 0xff}),
+			Pressed:  image2.NewNineSliceColor(color.RGBA{R: 0x89, G: 0xd7, B: 0x99, A: 0xff}),
+			Disabled: image2.NewNineSliceColor(color.RGBA{R: 0x66, G: 0x05, B: 0x28, A: 0xff}),
+		}),
+		widget.ButtonOpts.Text("UPGRADE", font.TTF32, &widget.ButtonTextColor{
+			Idle:     color.White,
+			Disabled: color.Black,
+		}),
+		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.MinSize(0, 80)),
+		widget.ButtonOpts.ClickedHandler(s.handleUpgrade(id)),
+		widget.ButtonOpts.CursorEnteredHandler(func(_ *widget.ButtonHoverEventArgs) {
+			if hover != nil {
+				hover()
+			}
+		}),
+	)
+}
+
+// upgradeTreeText returns the tree of the tower's upgrades as BBCode text.
+func upgradeTreeText(t *ingame.Tower) string {
+	var b strings.Builder
+	for i, n := range t.UpgradeTree() {
+		if i != 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%s[color=%s]%s[/color]", strings.Repeat("  ", n.Depth), upgradeColors[n.Status], n.Upgrade.Name)
+	}
+
+	return b.String()
+}
+
 // textUpgradeInfo creates a container that contains the info about the tower.
 func (s *GameState) textUpgradeInfo() *widget.Container {
 	root := widget.NewContainer(
@@ -237,109 +332,111 @@ func (s *GameState) tuningContainer(_ general.Widgets) *widget.Container {
 	return root
 }
 
-// radio creates a radio group.
+// radio creates a radio group of the tower's aims.
 func (s *GameState) radio() *widget.Container {
-
 	root := widget.NewContainer(
-		widget.ContainerOpts.Layout(widget.NewRowLayout(
-			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
-			widget.RowLayoutOpts.Spacing(20),
-			widget.RowLayoutOpts.Padding(widget.Insets{Top: 40}),
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(2),
+			widget.GridLayoutOpts.Stretch([]bool{true, true}, nil),
+			widget.GridLayoutOpts.Spacing(10, 10),
+			widget.GridLayoutOpts.Padding(widget.Insets{Top: 20}),
 		)),
 	)
 
-	first := widget.NewButton(
-		widget.ButtonOpts.TextPadding(widget.Insets{
-			Top:    10,
-			Left:   0,
-			Right:  0,
-			Bottom: 10,
-		}),
-		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
-			Position: widget.RowLayoutPositionCenter,
-			Stretch:  true,
-		})),
-		widget.ButtonOpts.Text("First", font.TTF40, &widget.ButtonTextColor{
-			Idle: color.White,
-		}),
-		widget.ButtonOpts.Image(&widget.ButtonImage{
-			Idle:    image2.NewNineSliceColor(color.RGBA{R: 0x7f, G: 0x27, B: 0xd7, A: 0xff}),
-			Hover:   image2.NewNineSliceColor(color.RGBA{R: 0x9a, G: 0x3b, B: 0xea, A: 0xff}),
-			Pressed: image2.NewNineSliceColor(color.RGBA{R: 0x6a, G: 0x16, B: 0xc2, A: 0xff}),
-		}),
-		widget.ButtonOpts.ClickedHandler(s.handleTuneFirst),
+	buttons := make(map[ingame.Aim]*widget.Button, len(ingame.Aims))
+	elements := make([]widget.RadioGroupElement, 0, len(ingame.Aims))
+	for _, aim := range ingame.Aims {
+		btn := widget.NewButton(
+			widget.ButtonOpts.TextPadding(widget.Insets{
+				Top:    5,
+				Left:   0,
+				Right:  0,
+				Bottom: 5,
+			}),
+			widget.ButtonOpts.Text(aim.String(), font.TTF32, &widget.ButtonTextColor{
+				Idle: color.White,
+			}),
+			widget.ButtonOpts.Image(&widget.ButtonImage{
+				Idle:    image2.NewNineSliceColor(color.RGBA{R: 0x7f, G: 0x27, B: 0xd7, A: 0xff}),
+				Hover:   image2.NewNineSliceColor(color.RGBA{R: 0x9a, G: 0x3b, B: 0xea, A: 0xff}),
+				Pressed: image2.NewNineSliceColor(color.RGBA{R: 0x6a, G: 0x16, B: 0xc2, A: 0xff}),
+			}),
+			widget.ButtonOpts.ClickedHandler(s.handleTune(aim)),
+		)
+
+		buttons[aim] = btn
+		elements = append(elements, btn)
+		root.AddChild(btn)
+	}
+
+	r := widget.NewRadioGroup(
+		widget.RadioGroupOpts.Elements(elements...),
 	)
 
-	strong := widget.NewButton(
-		widget.ButtonOpts.TextPadding(widget.Insets{
-			Top:    10,
-			Left:   0,
-			Right:  0,
-			Bottom: 10,
-		}),
-		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
-			Position: widget.RowLayoutPositionCenter,
-			Stretch:  true,
-		})),
-		widget.ButtonOpts.Text("Strong", font.TTF40, &widget.ButtonTextColor{
-			Idle: color.White,
-		}),
-		widget.ButtonOpts.Image(&widget.ButtonImage{
-			Idle:    image2.NewNineSliceColor(color.RGBA{R: 0x7f, G: 0x27, B: 0xd7, A: 0xff}),
-			Hover:   image2.NewNineSliceColor(color.RGBA{R: 0x9a, G: 0x3b, B: 0xea, A: 0xff}),
-			Pressed: image2.NewNineSliceColor(color.RGBA{R: 0x6a, G: 0x16, B: 0xc2, A: 0xff}),
-		}),
-		widget.ButtonOpts.ClickedHandler(s.handleTuneStrong),
+	s.uiUpdater.Append(func() {
+		if s.chosenTower == nil {
+			return
+		}
+
+		if btn, ok := buttons[s.chosenTower.State.AimType]; ok {
+			r.SetActive(btn)
+		}
+	})
+
+	return root
+}
+
+// abilityContainer creates a container that contains the button of the tower's ability.
+// The container is hidden if the tower has no ability.
+func (s *GameState) abilityContainer(_ general.Widgets) *widget.Container {
+	root := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(1),
+			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{true}),
+			widget.GridLayoutOpts.Padding(widget.Insets{Top: 20}),
+		)),
 	)
 
-	weak := widget.NewButton(
-		widget.ButtonOpts.TextPadding(widget.Insets{
-			Top:    10,
-			Left:   0,
-			Right:  0,
-			Bottom: 10,
-		}),
-		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
-			Position: widget.RowLayoutPositionCenter,
-			Stretch:  true,
-		})),
-		widget.ButtonOpts.Text("Weak", font.TTF40, &widget.ButtonTextColor{
-			Idle: color.White,
-		}),
+	btnAbility := widget.NewButton(
 		widget.ButtonOpts.Image(&widget.ButtonImage{
-			Idle:    image2.NewNineSliceColor(color.RGBA{R: 0x7f, G: 0x27, B: 0xd7, A: 0xff}),
-			Hover:   image2.NewNineSliceColor(color.RGBA{R: 0x9a, G: 0x3b, B: 0xea, A: 0xff}),
-			Pressed: image2.NewNineSliceColor(color.RGBA{R: 0x6a, G: 0x16, B: 0xc2, A: 0xff}),
+			Idle:     image2.NewNineSliceColor(colornames.Darkorange),
+			Hover:    image2.NewNineSliceColor(colornames.Orange),
+			Disabled: image2.NewNineSliceColor(colornames.Dimgray),
 		}),
-		widget.ButtonOpts.ClickedHandler(s.handleTuneWeak),
-	)
-
-	root.AddChild(first)
-	root.AddChild(strong)
-	root.AddChild(weak)
-
-	r := widget.NewRadioGroup(
-		widget.RadioGroupOpts.Elements(first, strong, weak),
+		widget.ButtonOpts.Text("", font.TTF32, &widget.ButtonTextColor{
+			Idle:     color.White,
+			Disabled: color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 180},
+		}),
+		widget.ButtonOpts.ClickedHandler(s.handleTowerAbility),
+		widget.ButtonOpts.TextPadding(widget.Insets{Top: 5, Bottom: 5}),
 	)
 
 	s.uiUpdater.Append(func() {
-		if s.chosenTower == nil {
+		if s.chosenTower == nil || s.chosenTower.Ability == nil {
+			root.GetWidget().Visibility = widget.Visibility_Hide
 			return
 		}
 
-		switch s.chosenTower.State.AimType {
-		case ingame.First:
-			r.SetActive(first)
-		case ingame.Strongest:
-			r.SetActive(strong)
-		case ingame.Weakest:
-			r.SetActive(weak)
-		}
+		a := s.chosenTower.Ability
+		root.GetWidget().Visibility = widget.Visibility_Show
+		btnAbility.Text().Label = abilityLabel(a)
+		btnAbility.GetWidget().Disabled = s.State != controller.Running || !a.Ready()
 	})
 
+	root.AddChild(btnAbility)
+
 	return root
 }
 
+// abilityLabel returns the label of the ability's button with the cooldown left in seconds.
+func abilityLabel(a *ingame.Ability) string {
+	if a.Ready() {
+		return strings.ToUpper(a.Name)
+	}
+
+	return fmt.Sprintf("%s %ds", strings.ToUpper(a.Name), (a.State.CoolDown+59)/60)
+}
+
 // sellContainer creates a container that contains the sell button.
 func (s *GameState) sellContainer(_ general.Widgets) *widget.Container {
 	root := widget.NewContainer(
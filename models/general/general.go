@@ -19,6 +19,28 @@ type Point struct {
 	Y Coord
 }
 
+// Lerp returns the point between p and q at the fraction t of the way from p to q.
+func (p Point) Lerp(q Point, t Coord) Point {
+	return Point{X: p.X + (q.X-p.X)*t, Y: p.Y + (q.Y-p.Y)*t}
+}
+
+// Polygon is a closed polygon given by its vertices.
+type Polygon []Point
+
+// Contains checks if the point is inside the polygon.
+func (pg Polygon) Contains(p Point) bool {
+	in := false
+	for i, j := 0, len(pg)-1; i < len(pg); j, i = i, i+1 {
+		a, b := pg[i], pg[j]
+		// ray casting: counts the edges crossed by the ray from p to the right
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			in = !in
+		}
+	}
+
+	return in
+}
+
 // TypeAttack is an enum that represents the type of attack.
 type TypeAttack int
 
@@ -0,0 +1,36 @@
+package general
+
+import (
+	"math"
+)
+
+// The simulation must go the same way on every machine, because the replays
+// and the co-op games are played again from the actions of the players only.
+//
+// The basic operations (+, -, *, / and math.Sqrt) round their results the same
+// way on every platform, but the compiler may fuse x*y + z into one instruction
+// that skips the rounding of x*y (it does on arm64 and on amd64 with GOAMD64=v3).
+// So the products added to something in the simulation are rounded explicitly
+// by a conversion, as the spec says. The other functions of the math package
+// may be implemented in assembly on some platforms and aren't used in it.
+
+// Hypot returns Sqrt(p*p + q*q) the way math.Hypot does on amd64.
+// Unlike math.Hypot it is computed the same way on every platform.
+func Hypot(p, q float64) float64 {
+	p, q = math.Abs(p), math.Abs(q)
+	if p < q {
+		p, q = q, p
+	}
+	if p == 0 {
+		return 0
+	}
+
+	q /= p
+	return p * math.Sqrt(1+float64(q*q))
+}
+
+// Distance2 returns the squared distance between the points.
+func Distance2(a, b Point) Coord {
+	dx, dy := a.X-b.X, a.Y-b.Y
+	return Coord(dx*dx) + Coord(dy*dy)
+}
@@ -0,0 +1,139 @@
+package ingame
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/general"
+)
+
+// Ability is an active ability of a tower or the player.
+type Ability struct {
+	// Name is a name of the ability.
+	Name string
+
+	// Kind is a kind of the ability.
+	Kind config.AbilityKind
+
+	// Cooldown is a time after the activation when the ability can't be used.
+	Cooldown general.Frames
+
+	// Duration is a time the overdrive lasts or the airstrike flies.
+	Duration general.Frames
+
+	// DeltaDamage is a delta of the damage of the overdrive.
+	DeltaDamage int
+
+	// DeltaRadius is a delta of the radius of the overdrive.
+	DeltaRadius general.Coord
+
+	// DeltaSpeedAttack is a delta of the speed of the attack of the overdrive.
+	DeltaSpeedAttack general.Frames
+
+	// Damage is a damage of the airstrike.
+	Damage int
+
+	// Radius is a radius of the airstrike.
+	Radius general.Coord
+
+	// State is a state of the ability.
+	State AbilityState
+}
+
+// AbilityState is a state of the ability.
+type AbilityState struct {
+	// CoolDown is a time left until the ability can be used again.
+	CoolDown general.Frames
+
+	// Active is a time left until the effect of the ability ends.
+	Active general.Frames
+
+	// Target is a point the airstrike is aimed at.
+	Target general.Point
+}
+
+// NewAbility creates a new entity of Ability.
+// Returns nil if the config is nil.
+func NewAbility(config *config.Ability) *Ability {
+	if config == nil {
+		return nil
+	}
+
+	return &Ability{
+		Name:             config.Name,
+		Kind:             config.Kind,
+		Cooldown:         config.Cooldown,
+		Duration:         config.Duration,
+		DeltaDamage:      config.DeltaDamage,
+		DeltaRadius:      config.DeltaRadius,
+		DeltaSpeedAttack: config.DeltaSpeedAttack,
+		Damage:           config.Damage,
+		Radius:           config.Radius,
+	}
+}
+
+// NewAbilities creates the abilities from the configs.
+func NewAbilities(configs []config.Ability) []*Ability {
+	abs := make([]*Ability, len(configs))
+	for i := range configs {
+		abs[i] = NewAbility(&configs[i])
+	}
+
+	return abs
+}
+
+// Ready checks if the ability can be used now.
+func (a *Ability) Ready() bool {
+	return a.State.CoolDown == 0
+}
+
+// Activate activates the ability aimed at the target.
+// Returns false if the ability is on cooldown.
+func (a *Ability) Activate(target general.Point) bool {
+	if !a.Ready() {
+		return false
+	}
+
+	a.State.CoolDown = a.Cooldown
+	a.State.Active = max(a.Duration, 1)
+	a.State.Target = target
+
+	return true
+}
+
+// Update updates the state of the ability.
+// Returns true if the effect of the ability ends on this tick.
+func (a *Ability) Update() bool {
+	a.State.CoolDown = max(a.State.CoolDown-1, 0)
+	if a.State.Active == 0 {
+		return false
+	}
+
+	a.State.Active--
+	return a.State.Active == 0
+}
+
+// Modify adds the deltas of the overdrive to the stats while it is active.
+func (a *Ability) Modify(s Stats) Stats {
+	if a.Kind != config.AbilityOverdrive || a.State.Active == 0 {
+		return s
+	}
+
+	s.Damage += a.DeltaDamage
+	s.Radius += a.DeltaRadius
+	s.SpeedAttack += a.DeltaSpeedAttack
+
+	return s
+}
+
+// Draw draws the target of the flying airstrike.
+func (a *Ability) Draw(screen *ebiten.Image) {
+	if a.Kind != config.AbilityAirstrike || a.State.Active == 0 {
+		return
+	}
+
+	vector.StrokeCircle(screen, a.State.Target.X, a.State.Target.Y, a.Radius, 3, color.RGBA{R: 0xff, A: 0xa0}, true)
+}
@@ -0,0 +1,144 @@
+package ingame
+
+import (
+	"slices"
+
+	"github.com/gopher-co/td-game/models/config"
+)
+
+// Achievements is the progress of the player's achievements.
+type Achievements struct {
+	// Unlocked is a set of the names of the unlocked achievements.
+	Unlocked map[string]struct{} `json:"unlocked"`
+
+	// Kills is a number of the enemies killed in all the games.
+	Kills int `json:"kills"`
+}
+
+// IsUnlocked returns true if the achievement with the name is unlocked.
+func (a *Achievements) IsUnlocked(name string) bool {
+	_, ok := a.Unlocked[name]
+	return ok
+}
+
+// unlock marks the achievement with the name unlocked.
+// Returns false if it has already been unlocked.
+func (a *Achievements) unlock(name string) bool {
+	if a.IsUnlocked(name) {
+		return false
+	}
+	if a.Unlocked == nil {
+		a.Unlocked = make(map[string]struct{})
+	}
+	a.Unlocked[name] = struct{}{}
+
+	return true
+}
+
+// Achiever evaluates the achievements against the events of one game.
+type Achiever struct {
+	// Configs are the configs of the achievements.
+	Configs []*config.Achievement
+
+	// Progress is the progress of the player updated by the game.
+	Progress *Achievements
+
+	// Level is a name of the level of the game.
+	Level string
+
+	// towers is a number of the towers built in the game by their names.
+	// The towers whose placement is undone aren't counted.
+	towers map[string]int
+
+	// leaked is a flag that shows if any enemy has passed its path in the game.
+	leaked bool
+
+	// unlocked is a list of the names of the achievements unlocked in the game.
+	unlocked []string
+}
+
+// NewAchiever creates a new entity of Achiever for the game on the level.
+func NewAchiever(configs []*config.Achievement, progress *Achievements, level string) *Achiever {
+	return &Achiever{
+		Configs:  configs,
+		Progress: progress,
+		Level:    level,
+		towers:   make(map[string]int),
+	}
+}
+
+// Handle updates the progress by the event.
+// It is a Subscriber of the map of the game.
+func (a *Achiever) Handle(e Event) {
+	switch e := e.(type) {
+	case EnemyKilled:
+		a.Progress.Kills++
+		for _, c := range a.Configs {
+			if c.Kind == config.AchievementKills && a.Progress.Kills >= c.Count {
+				a.unlock(c)
+			}
+		}
+	case EnemyLeaked:
+		a.leaked = a.leaked || e.Damage > 0
+	case TowerBuilt:
+		a.towers[e.Tower.Name]++
+	case TowerUnbuilt:
+		a.towers[e.Tower.Name]--
+		if a.towers[e.Tower.Name] <= 0 {
+			delete(a.towers, e.Tower.Name)
+		}
+	}
+}
+
+// Resume counts the restored part of the game: the towers on the map including the sold ones
+// are built in it and the enemies have leaked in it if the player has lost the health.
+// The kills of the restored part are already counted in the progress.
+func (a *Achiever) Resume(towers []*Tower, leaked bool) {
+	for _, t := range towers {
+		a.towers[t.Name]++
+	}
+	a.leaked = a.leaked || leaked
+}
+
+// Finish checks the conditions of the achievements at the end of the game.
+// Returns the names of the achievements unlocked in the game.
+func (a *Achiever) Finish(win bool) []string {
+	if win {
+		for _, c := range a.Configs {
+			if c.Level != "" && c.Level != a.Level {
+				continue
+			}
+
+			switch c.Kind {
+			case config.AchievementFlawless:
+				if !a.leaked {
+					a.unlock(c)
+				}
+			case config.AchievementOnlyTowers:
+				if a.onlyTowers(c.Towers) {
+					a.unlock(c)
+				}
+			}
+		}
+	}
+
+	return a.unlocked
+}
+
+// onlyTowers returns true if all the towers built in the game are in the list.
+func (a *Achiever) onlyTowers(names []string) bool {
+	for t := range a.towers {
+		if !slices.Contains(names, t) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// unlock unlocks the achievement and remembers it if it's unlocked for the first time.
+func (a *Achiever) unlock(c *config.Achievement) {
+	if a.Progress.unlock(c.Name) {
+		a.unlocked = append(a.unlocked, c.Name)
+	}
+}
@@ -0,0 +1,91 @@
+package ingame_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+func TestAchiever(t *testing.T) {
+	configs := []*config.Achievement{
+		{Name: "kills", Kind: config.AchievementKills, Count: 2},
+		{Name: "flawless", Kind: config.AchievementFlawless},
+		{Name: "gophers", Kind: config.AchievementOnlyTowers, Towers: []string{"Gopher"}},
+		{Name: "other level", Kind: config.AchievementFlawless, Level: "other"},
+	}
+	progress := &ingame.Achievements{Kills: 1}
+
+	m := &ingame.Map{}
+	a := ingame.NewAchiever(configs, progress, "level")
+	m.Subscribe(a.Handle)
+
+	m.Build(&ingame.Tower{Name: "Gopher"})
+	m.Build(&ingame.Tower{Name: "Wizard"})
+	m.Emit(ingame.EnemyKilled{Enemy: &ingame.Enemy{}})
+
+	if !progress.IsUnlocked("kills") || progress.Kills != 2 {
+		t.Errorf("kills achievement isn't unlocked after %d kills", progress.Kills)
+	}
+
+	unlocked := a.Finish(true)
+	if !slices.Equal(unlocked, []string{"kills", "flawless"}) {
+		t.Errorf("unlocked = %v, want [kills flawless]", unlocked)
+	}
+
+	b := ingame.NewAchiever(configs, progress, "level")
+	m = &ingame.Map{}
+	m.Subscribe(b.Handle)
+	m.Build(&ingame.Tower{Name: "Gopher"})
+	m.Emit(ingame.EnemyLeaked{Enemy: &ingame.Enemy{}, Damage: 1})
+
+	if unlocked := b.Finish(false); len(unlocked) != 0 {
+		t.Errorf("achievements unlocked on loss: %v", unlocked)
+	}
+	if unlocked := b.Finish(true); !slices.Equal(unlocked, []string{"gophers"}) {
+		t.Errorf("unlocked = %v, want [gophers]", unlocked)
+	}
+}
+
+func TestAchieverUndo(t *testing.T) {
+	configs := []*config.Achievement{
+		{Name: "gophers", Kind: config.AchievementOnlyTowers, Towers: []string{"Gopher"}},
+	}
+	progress := &ingame.Achievements{}
+
+	m := &ingame.Map{}
+	a := ingame.NewAchiever(configs, progress, "level")
+	m.Subscribe(a.Handle)
+
+	m.Build(&ingame.Tower{Name: "Gopher"})
+	wizard := &ingame.Tower{Name: "Wizard"}
+	m.Build(wizard)
+	m.Unbuild(wizard)
+
+	if len(m.Towers) != 1 {
+		t.Errorf("%d towers on the map, want 1", len(m.Towers))
+	}
+	if unlocked := a.Finish(true); !slices.Equal(unlocked, []string{"gophers"}) {
+		t.Errorf("unlocked = %v, want [gophers]", unlocked)
+	}
+}
+
+func TestAchieverResume(t *testing.T) {
+	configs := []*config.Achievement{
+		{Name: "gophers", Kind: config.AchievementOnlyTowers, Towers: []string{"Gopher"}},
+		{Name: "flawless", Kind: config.AchievementFlawless},
+	}
+
+	a := ingame.NewAchiever(configs, &ingame.Achievements{}, "level")
+	a.Resume([]*ingame.Tower{{Name: "Gopher"}, {Name: "Wizard", Sold: true}}, true)
+	if unlocked := a.Finish(true); len(unlocked) != 0 {
+		t.Errorf("unlocked = %v, want none", unlocked)
+	}
+
+	a = ingame.NewAchiever(configs, &ingame.Achievements{}, "level")
+	a.Resume([]*ingame.Tower{{Name: "Gopher"}, {Name: "Gopher"}}, false)
+	if unlocked := a.Finish(true); !slices.Equal(unlocked, []string{"gophers", "flawless"}) {
+		t.Errorf("unlocked = %v, want [gophers flawless]", unlocked)
+	}
+}
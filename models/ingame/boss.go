@@ -0,0 +1,133 @@
+package ingame
+
+import (
+	"math"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/general"
+)
+
+// Phase is a phase of the enemy triggered at the health threshold.
+type Phase struct {
+	// HealthPercent is a percent of the maximal health that triggers the phase.
+	HealthPercent int
+
+	// SpeedMultiplier multiplies the speed of the enemy.
+	SpeedMultiplier float64
+
+	// Strengths replaces the strengths of the enemy if it isn't nil.
+	Strengths map[general.TypeAttack]Strength
+
+	// Adds is a list of the names of the enemies spawned at the enemy's position.
+	Adds []string
+
+	// Immune is a time the enemy takes no damage after the phase is triggered.
+	Immune general.Frames
+}
+
+// NewPhases creates the phases from the configs.
+func NewPhases(configs []config.Phase) []Phase {
+	ps := make([]Phase, len(configs))
+	for i, c := range configs {
+		ps[i] = Phase{
+			HealthPercent:   c.HealthPercent,
+			SpeedMultiplier: c.SpeedMultiplier,
+			Adds:            c.Adds,
+			Immune:          c.Immune,
+		}
+
+		if c.Strengths != nil {
+			ps[i].Strengths = make(map[general.TypeAttack]Strength, len(c.Strengths))
+			for _, v := range c.Strengths {
+				ps[i].Strengths[v.T] = Strength(v)
+			}
+		}
+	}
+
+	return ps
+}
+
+// Immune checks if the enemy takes no damage now.
+func (e *Enemy) Immune() bool {
+	return e.State.Immune > 0
+}
+
+// updatePhases triggers the phases whose health thresholds are reached.
+func (e *Enemy) updatePhases() {
+	e.State.Immune = max(e.State.Immune-1, 0)
+
+	for e.State.Phase < len(e.Phases) {
+		p := e.Phases[e.State.Phase]
+		if e.State.Health*100 > e.MaxHealth*p.HealthPercent {
+			return
+		}
+
+		e.State.Phase++
+		e.enterPhase(p)
+	}
+}
+
+// enterPhase applies the phase p to the enemy.
+func (e *Enemy) enterPhase(p Phase) {
+	if p.SpeedMultiplier > 0 {
+		e.Vrms *= general.Coord(p.SpeedMultiplier)
+		e.retarget()
+	}
+	if p.Strengths != nil {
+		e.Strengths = p.Strengths
+	}
+
+	e.State.Adds = append(e.State.Adds, p.Adds...)
+	e.State.Immune = max(e.State.Immune, p.Immune)
+}
+
+// retarget directs the enemy from its current position to the next point of the path
+// with its current speed.
+func (e *Enemy) retarget() {
+	if e.State.CurrPoint < 0 || e.State.CurrPoint >= len(e.Path)-1 {
+		return
+	}
+
+	next := e.Path[e.State.CurrPoint+1]
+	dX := next.X - e.State.Pos.X
+	dY := next.Y - e.State.Pos.Y
+
+	frameTime := max(1, int(math.Round(general.Hypot(float64(dX), float64(dY))/float64(e.Vrms))))
+
+	e.State.Vx = dX / general.Coord(frameTime)
+	e.State.Vy = dY / general.Coord(frameTime)
+	e.State.TimeNextPointLeft = frameTime
+}
+
+// NewAdd creates the enemy from the config spawned by the enemy e.
+// The new enemy goes along the path of e from its current position.
+func (e *Enemy) NewAdd(cfg *config.Enemy) *Enemy {
+	add := NewEnemy(cfg, e.Path)
+	add.State.CurrPoint = e.State.CurrPoint
+	add.State.Pos = e.State.Pos
+	add.retarget()
+
+	return add
+}
+
+// SpawnAdds creates the enemies spawned by the phases of the enemies on the map.
+func (m *Map) SpawnAdds(configs map[string]*config.Enemy) {
+	n := len(m.Enemies)
+	for _, e := range m.Enemies[:n] {
+		for _, name := range e.State.Adds {
+			m.Spawn(e.NewAdd(configs[name]))
+		}
+		e.State.Adds = nil
+	}
+}
+
+// Boss returns the first alive boss on the map or nil.
+func (m *Map) Boss() *Enemy {
+	for _, e := range m.Enemies {
+		if e.Boss && !e.State.Dead {
+			return e
+		}
+	}
+
+	return nil
+}
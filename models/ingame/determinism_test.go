@@ -0,0 +1,53 @@
+package ingame_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// deterministicMath are the functions of the math package computed the same way on every platform.
+var deterministicMath = map[string]bool{
+	"Abs":         true,
+	"Float32bits": true,
+	"Round":       true,
+	"Signbit":     true,
+	"Sqrt":        true,
+}
+
+func TestDeterministicMath(t *testing.T) {
+	for _, dir := range []string{".", "../general", "../config", "../controller"} {
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, f := range files {
+			if !strings.HasSuffix(f.Name(), ".go") || strings.HasSuffix(f.Name(), "_test.go") {
+				continue
+			}
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, filepath.Join(dir, f.Name()), nil, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ast.Inspect(file, func(n ast.Node) bool {
+				sel, ok := n.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				if x, ok := sel.X.(*ast.Ident); ok && x.Name == "math" && !deterministicMath[sel.Sel.Name] {
+					t.Errorf("%s: math.%s may differ on the platforms", fset.Position(sel.Pos()), sel.Sel.Name)
+				}
+
+				return true
+			})
+		}
+	}
+}
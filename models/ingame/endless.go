@@ -0,0 +1,91 @@
+package ingame
+
+import (
+	"cmp"
+	"errors"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/gopher-co/td-game/models/config"
+)
+
+// Endless generates the waves of the endless mode that follow the scripted waves of the level.
+//
+// The n-th wave depends only on the seed and n, so the waves are the same
+// for the same seed whatever order they are generated in.
+type Endless struct {
+	// Seed is a seed of the random generator of the waves.
+	Seed uint64
+
+	// Scripted is a number of the scripted waves of the level.
+	Scripted int
+
+	// enemies are the enemies that can be called sorted by their strength.
+	enemies []*config.Enemy
+
+	// paths are the names of the paths the enemies can go along.
+	paths []string
+}
+
+// NewEndless creates a new entity of Endless that calls the enemies along the paths
+// after the scripted waves.
+// Returns an error if there are no enemies or no paths for the waves.
+func NewEndless(seed uint64, scripted int, enemies map[string]*config.Enemy, paths Paths) (*Endless, error) {
+	if len(enemies) == 0 {
+		return nil, errors.New("no enemies to call in the endless waves")
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("no paths for the endless waves")
+	}
+
+	es := make([]*config.Enemy, 0, len(enemies))
+	for _, e := range enemies {
+		es = append(es, e)
+	}
+	slices.SortFunc(es, func(a, b *config.Enemy) int {
+		return cmp.Or(cmp.Compare(a.MaxHealth, b.MaxHealth), cmp.Compare(a.Name, b.Name))
+	})
+
+	ps := make([]string, 0, len(paths))
+	for k := range paths {
+		ps = append(ps, k)
+	}
+	slices.Sort(ps)
+
+	return &Endless{Seed: seed, Scripted: scripted, enemies: es, paths: ps}, nil
+}
+
+// Wave returns the n-th endless wave counting from zero.
+//
+// The later the wave is, the more swarms and the more enemies it has,
+// the stronger enemies are mixed in and the more health they have.
+func (e *Endless) Wave(n int) config.Wave {
+	rng := rand.New(rand.NewPCG(e.Seed, uint64(n)))
+
+	kinds := 1 + min(n/3, 3)
+	pool := min(len(e.enemies), 1+n/2)
+	count := 5 + 2*n
+	interval := max(10, 40-n)
+	// the product is rounded before the sum to be the same on every platform, see general/math.go
+	health := 1 + float64(0.15*float64(n+1))
+
+	w := config.Wave{Swarms: make([]config.EnemySwarm, kinds)}
+	for i := range w.Swarms {
+		w.Swarms[i] = config.EnemySwarm{
+			EnemyName:        e.enemies[rng.IntN(pool)].Name,
+			Timeout:          i*120 + rng.IntN(60),
+			Interval:         interval,
+			MaxCalls:         max(1, count/kinds),
+			PathName:         e.paths[rng.IntN(len(e.paths))],
+			HealthMultiplier: health,
+		}
+	}
+
+	return w
+}
+
+// Extend appends the next endless wave to the game rule and returns it.
+func (e *Endless) Extend(gr GameRule) GameRule {
+	w := e.Wave(len(gr) - e.Scripted)
+	return append(gr, NewWave(&w))
+}
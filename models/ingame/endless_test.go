@@ -0,0 +1,64 @@
+package ingame_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+// newEndless creates the endless waves after two scripted ones or fails the test.
+func newEndless(t *testing.T, seed uint64, enemies map[string]*config.Enemy, paths ingame.Paths) *ingame.Endless {
+	t.Helper()
+	e, err := ingame.NewEndless(seed, 2, enemies, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return e
+}
+
+func TestEndless(t *testing.T) {
+	enemies := map[string]*config.Enemy{
+		"weak":   {Name: "weak", MaxHealth: 10},
+		"medium": {Name: "medium", MaxHealth: 50},
+		"strong": {Name: "strong", MaxHealth: 200},
+	}
+	paths := ingame.Paths{"main": {{X: 0, Y: 0}, {X: 100, Y: 0}}, "side": {{X: 0, Y: 100}, {X: 100, Y: 100}}}
+
+	e1 := newEndless(t, 42, enemies, paths)
+	e2 := newEndless(t, 42, enemies, paths)
+
+	if !reflect.DeepEqual(e1.Wave(7), e2.Wave(7)) {
+		t.Error("the waves with the same seed differ")
+	}
+	if reflect.DeepEqual(e1.Wave(7), newEndless(t, 43, enemies, paths).Wave(7)) {
+		t.Error("the waves with different seeds are the same")
+	}
+
+	first, later := e1.Wave(0), e1.Wave(10)
+	if first.Swarms[0].EnemyName != "weak" {
+		t.Errorf("the first wave calls %v, want weak", first.Swarms[0].EnemyName)
+	}
+	if len(later.Swarms) <= len(first.Swarms) || later.Swarms[0].HealthMultiplier <= first.Swarms[0].HealthMultiplier {
+		t.Error("the later wave isn't harder")
+	}
+
+	gr := e1.Extend(ingame.GameRule{{}, {}})
+	if len(gr) != 3 || !reflect.DeepEqual(gr[2], ingame.NewWave(&first)) {
+		t.Error("the first endless wave isn't appended after the scripted ones")
+	}
+}
+
+func TestEndlessWithoutEnemiesOrPaths(t *testing.T) {
+	enemies := map[string]*config.Enemy{"weak": {Name: "weak", MaxHealth: 10}}
+	paths := ingame.Paths{"main": {{X: 0, Y: 0}, {X: 100, Y: 0}}}
+
+	if _, err := ingame.NewEndless(42, 2, nil, paths); err == nil {
+		t.Error("the endless waves without enemies are created")
+	}
+	if _, err := ingame.NewEndless(42, 2, enemies, ingame.Paths{}); err == nil {
+		t.Error("the endless waves without paths are created")
+	}
+}
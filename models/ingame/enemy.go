@@ -37,14 +37,31 @@ type Enemy struct {
 	// MoneyAward is a money award for killing the enemy.
 	MoneyAward int
 
+	// Evasion is a percent of the projectiles the enemy evades.
+	Evasion int
+
+	// Hidden is a flag that shows if the enemy can be attacked
+	// only when it is revealed by a detector tower.
+	Hidden bool
+
 	// Weaknesses is a list of weaknesses of the enemy.
 	Weaknesses map[general.TypeAttack]Weakness
 
 	// Strengths is a list of strengths of the enemy.
 	Strengths map[general.TypeAttack]Strength
 
+	// Boss is a flag that shows if the enemy is a boss.
+	Boss bool
+
+	// Phases is a list of the phases of the enemy in the order of triggering.
+	Phases []Phase
+
 	// Image is an image of the enemy.
 	Image *ebiten.Image
+
+	// prevPos is a position of the enemy at the previous frame.
+	// The enemy is drawn between it and its current position.
+	prevPos general.Point
 }
 
 // NewEnemy creates a new entity of Enemy.
@@ -64,8 +81,12 @@ func NewEnemy(cfg *config.Enemy, path Path) *Enemy {
 		Vrms:       cfg.Vrms,
 		Damage:     cfg.Damage,
 		MoneyAward: cfg.MoneyAward,
+		Evasion:    cfg.Evasion,
+		Hidden:     cfg.Hidden,
 		Weaknesses: map[general.TypeAttack]Weakness{},
 		Strengths:  map[general.TypeAttack]Strength{},
+		Boss:       cfg.Boss,
+		Phases:     NewPhases(cfg.Phases),
 	}
 
 	for _, v := range cfg.Strengths {
@@ -104,15 +125,35 @@ func (e *Enemy) DealDamageToPlayer() int {
 
 // DealDamage decreases the health of the enemy on dmg points.
 // If health is less than dmg, health will become zero.
+// The immune enemy takes no damage.
 func (e *Enemy) DealDamage(dmg int) {
+	if e.Immune() {
+		return
+	}
 	e.State.Health = max(0, e.State.Health-dmg)
 }
 
+// Visible checks if the enemy can be attacked by the towers.
+func (e *Enemy) Visible() bool {
+	return !e.Hidden || e.State.Revealed
+}
+
 // Draw draws the enemy on the screen.
-func (e *Enemy) Draw(screen *ebiten.Image) {
+// It is drawn at the fraction alpha of the way from the previous frame to the current one.
+// Hidden enemies are drawn translucent.
+func (e *Enemy) Draw(screen *ebiten.Image, alpha general.Coord) {
+	pos := e.prevPos.Lerp(e.State.Pos, alpha)
 	geom := ebiten.GeoM{}
-	geom.Translate(float64(e.State.Pos.X-float32(e.Image.Bounds().Dx()/2)), float64(e.State.Pos.Y-float32(e.Image.Bounds().Dy()/2)))
-	screen.DrawImage(e.Image, &ebiten.DrawImageOptions{GeoM: geom})
+	geom.Translate(float64(pos.X-float32(e.Image.Bounds().Dx()/2)), float64(pos.Y-float32(e.Image.Bounds().Dy()/2)))
+
+	opts := &ebiten.DrawImageOptions{GeoM: geom}
+	if !e.Visible() {
+		opts.ColorScale.ScaleAlpha(0.3)
+	}
+	if e.Immune() {
+		opts.ColorScale.Scale(1, 1, 0.5, 1)
+	}
+	screen.DrawImage(e.Image, opts)
 }
 
 // changeDirection directs the enemy to a new point, if possible.
@@ -144,7 +185,7 @@ func (e *Enemy) changeDirection() {
 	dX := next.X - curr.X
 	dY := next.Y - curr.Y
 
-	t := math.Hypot(float64(dX), float64(dY)) / float64(e.Vrms) // t = S / Vrms
+	t := general.Hypot(float64(dX), float64(dY)) / float64(e.Vrms) // t = S / Vrms
 	frameTime := int(math.Round(t))
 
 	e.State.Vx = dX / general.Coord(frameTime)
@@ -189,6 +230,7 @@ func (e *Enemy) Update() {
 	if e.State.CurrPoint == -1 {
 		e.changeDirection()
 	}
+	e.updatePhases()
 	e.move()
 	if e.State.TimeNextPointLeft == 0 {
 		e.changeDirection()
@@ -230,6 +272,22 @@ type EnemyState struct {
 
 	// FinalDamage is a final damage to the player.
 	FinalDamage int
+
+	// Revealed is a flag that shows if the hidden enemy is revealed by a detector tower now.
+	Revealed bool
+
+	// Phase is a number of the phases triggered.
+	Phase int
+
+	// Immune is a time left until the enemy takes damage again.
+	Immune general.Frames
+
+	// Adds is a list of the names of the enemies to be spawned by the triggered phases.
+	Adds []string
+
+	// Killer is the tower that has dealt the lethal damage.
+	// It is saved by its index.
+	Killer *Tower `json:"-"`
 }
 
 // Weakness stores effects that are detrimental to the enemy
@@ -0,0 +1,88 @@
+package ingame
+
+// Event is a gameplay event emitted by the map to its subscribers.
+//
+// The enemies' and the projectiles' events are emitted by Map.Update,
+// the rest of them by the methods of the map called by the game state.
+type Event interface {
+	event()
+}
+
+// EnemySpawned is an event that is emitted when the enemy appears on the map.
+type EnemySpawned struct {
+	// Enemy is the spawned enemy.
+	Enemy *Enemy
+}
+
+// EnemyKilled is an event that is emitted when the enemy is killed.
+type EnemyKilled struct {
+	// Enemy is the killed enemy.
+	Enemy *Enemy
+
+	// Tower is the tower that killed the enemy.
+	// It is nil if the enemy is killed by the player's ability.
+	Tower *Tower
+}
+
+// EnemyLeaked is an event that is emitted when the enemy passes its path.
+type EnemyLeaked struct {
+	// Enemy is the leaked enemy.
+	Enemy *Enemy
+
+	// Damage is a damage the enemy deals to the player.
+	Damage int
+}
+
+// ProjectileFired is an event that is emitted when the tower launches the projectile.
+type ProjectileFired struct {
+	// Projectile is the fired projectile.
+	Projectile *Projectile
+}
+
+// TowerBuilt is an event that is emitted when the tower is built.
+type TowerBuilt struct {
+	// Tower is the built tower.
+	Tower *Tower
+}
+
+// TowerUnbuilt is an event that is emitted when the placement of the tower is undone.
+type TowerUnbuilt struct {
+	// Tower is the removed tower.
+	Tower *Tower
+}
+
+// WaveStarted is an event that is emitted when the wave is called.
+type WaveStarted struct {
+	// Wave is a number of the wave starting from zero.
+	Wave int
+}
+
+// WaveCleared is an event that is emitted when all the enemies of the wave are gone.
+type WaveCleared struct {
+	// Wave is a number of the wave starting from zero.
+	Wave int
+}
+
+func (EnemySpawned) event()    {}
+func (EnemyKilled) event()     {}
+func (EnemyLeaked) event()     {}
+func (ProjectileFired) event() {}
+func (TowerBuilt) event()      {}
+func (TowerUnbuilt) event()    {}
+func (WaveStarted) event()     {}
+func (WaveCleared) event()     {}
+
+// Subscriber is a function that handles the events of the map.
+type Subscriber func(e Event)
+
+// Subscribe adds the subscriber to the events of the map.
+func (m *Map) Subscribe(s Subscriber) {
+	m.subscribers = append(m.subscribers, s)
+}
+
+// Emit sends the event to the subscribers of the map in the order of subscribing.
+func (m *Map) Emit(e Event) {
+	for _, s := range m.subscribers {
+		s(e)
+	}
+}
@@ -0,0 +1,76 @@
+package ingame_test
+
+import (
+	"testing"
+
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+func TestMapEvents(t *testing.T) {
+	m := &ingame.Map{}
+	ps := &ingame.PlayerMapState{Health: 10}
+	m.Subscribe(ps.Handle)
+
+	var events []ingame.Event
+	m.Subscribe(func(e ingame.Event) {
+		events = append(events, e)
+	})
+
+	killed := &ingame.Enemy{
+		State:      ingame.EnemyState{CurrPoint: 0, Pos: general.Point{X: 100}},
+		Path:       ingame.Path{{X: 100}, {X: 1000}},
+		Vrms:       1,
+		MoneyAward: 5,
+	}
+	leaked := &ingame.Enemy{
+		State:  ingame.EnemyState{CurrPoint: -1, Pos: general.Point{X: 0, Y: 100}, Health: 1},
+		Path:   ingame.Path{{X: 0, Y: 100}, {X: 1, Y: 100}},
+		Vrms:   1,
+		Damage: 3,
+	}
+
+	m.StartWave(0)
+	m.Spawn(killed)
+	m.Spawn(leaked)
+	for range 3 {
+		m.Update()
+	}
+	m.ClearWave(0)
+
+	want := []string{"WaveStarted", "EnemySpawned", "EnemySpawned", "EnemyKilled", "EnemyLeaked", "WaveCleared"}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events %v, want %v", len(events), events, want)
+	}
+	for i, e := range events {
+		if name := eventName(e); name != want[i] {
+			t.Errorf("event %d = %s, want %s", i, name, want[i])
+		}
+	}
+
+	if ps.Money != 5 || ps.Health != 7 {
+		t.Errorf("player state = %+v, want money 5 and health 7", *ps)
+	}
+}
+
+// eventName returns the name of the type of the event.
+func eventName(e ingame.Event) string {
+	switch e.(type) {
+	case ingame.EnemySpawned:
+		return "EnemySpawned"
+	case ingame.EnemyKilled:
+		return "EnemyKilled"
+	case ingame.EnemyLeaked:
+		return "EnemyLeaked"
+	case ingame.ProjectileFired:
+		return "ProjectileFired"
+	case ingame.TowerBuilt:
+		return "TowerBuilt"
+	case ingame.WaveStarted:
+		return "WaveStarted"
+	case ingame.WaveCleared:
+		return "WaveCleared"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,117 @@
+package ingame
+
+import (
+	"math/bits"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/general"
+)
+
+// GridCellSize is a size of the cell of the enemies' grid.
+const GridCellSize = 64
+
+// Grid is a uniform grid that indexes the enemies by their positions.
+// It answers range queries without scanning all the enemies on the map.
+//
+// Enemies outside the map are put into the border cells,
+// so queries never miss them.
+type Grid struct {
+	// enemies is a list of enemies the grid was built from.
+	enemies []*Enemy
+
+	// cells contains the indices of the enemies in each cell.
+	cells [][]int
+
+	// cols is a number of the grid's columns.
+	cols int
+
+	// rows is a number of the grid's rows.
+	rows int
+
+	// buf is a buffer for the query results.
+	buf []*Enemy
+
+	// found marks the indices of the enemies found by the query.
+	found []uint64
+}
+
+// NewGrid creates a new empty Grid.
+func NewGrid() *Grid {
+	cols := (config.MapWidth + GridCellSize - 1) / GridCellSize
+	rows := (config.MapHeight + GridCellSize - 1) / GridCellSize
+
+	return &Grid{
+		cells: make([][]int, cols*rows),
+		cols:  cols,
+		rows:  rows,
+	}
+}
+
+// Rebuild puts the alive enemies into the grid.
+// The previous content of the grid is dropped.
+func (g *Grid) Rebuild(enemies []*Enemy) {
+	for i := range g.cells {
+		g.cells[i] = g.cells[i][:0]
+	}
+
+	g.enemies = enemies
+	// the marks are always cleared by Query, so the old buffer may be reused
+	if n := (len(enemies) + 63) / 64; cap(g.found) < n {
+		g.found = make([]uint64, n)
+	} else {
+		g.found = g.found[:n]
+	}
+	for i, e := range enemies {
+		if e.State.Dead {
+			continue
+		}
+
+		c := g.cell(e.State.Pos)
+		g.cells[c] = append(g.cells[c], i)
+	}
+}
+
+// Query returns the enemies that may be in the circle with the center pos.
+// The enemies are returned in the order they were passed to Rebuild,
+// so the choice of the target doesn't depend on the grid.
+//
+// The returned slice is valid until the next call of Query.
+func (g *Grid) Query(pos general.Point, radius general.Coord) []*Enemy {
+	x1, y1 := g.coords(general.Point{X: pos.X - radius, Y: pos.Y - radius})
+	x2, y2 := g.coords(general.Point{X: pos.X + radius, Y: pos.Y + radius})
+
+	for y := y1; y <= y2; y++ {
+		for x := x1; x <= x2; x++ {
+			for _, i := range g.cells[y*g.cols+x] {
+				g.found[i/64] |= 1 << (i % 64)
+			}
+		}
+	}
+
+	// collects the marked enemies in their original order and clears the marks
+	g.buf = g.buf[:0]
+	for w, bs := range g.found {
+		for bs != 0 {
+			i := w*64 + bits.TrailingZeros64(bs)
+			g.buf = append(g.buf, g.enemies[i])
+			bs &= bs - 1
+		}
+		g.found[w] = 0
+	}
+
+	return g.buf
+}
+
+// cell returns an index of the cell that contains the point.
+func (g *Grid) cell(p general.Point) int {
+	x, y := g.coords(p)
+	return y*g.cols + x
+}
+
+// coords returns the column and the row of the cell that contains the point.
+func (g *Grid) coords(p general.Point) (int, int) {
+	x := min(max(int(p.X)/GridCellSize, 0), g.cols-1)
+	y := min(max(int(p.Y)/GridCellSize, 0), g.rows-1)
+
+	return x, y
+}
@@ -0,0 +1,56 @@
+package ingame
+
+import "sort"
+
+// LeaderboardSize is a maximum number of the records in the table of a level on a difficulty.
+const LeaderboardSize = 10
+
+// Record is an entry of the leaderboard.
+type Record struct {
+	// Nickname is a nickname of the player.
+	Nickname string `json:"nickname"`
+
+	// Score is a score of the run.
+	Score int `json:"score"`
+
+	// Date is a date of the run.
+	Date string `json:"date"`
+
+	// Replay is a path to the replay file of the run.
+	Replay string `json:"replay"`
+}
+
+// Leaderboard is the top records of each level on each difficulty.
+// The first key is a name of the level, the second one is a name of the difficulty.
+type Leaderboard map[string]map[string][]Record
+
+// Add adds the record to the table of the level on the difficulty.
+// Returns the place of the record starting from zero or -1 if it's not in the top.
+func (lb Leaderboard) Add(level, difficulty string, r Record) int {
+	if lb[level] == nil {
+		lb[level] = make(map[string][]Record)
+	}
+
+	table := lb[level][difficulty]
+	place := sort.Search(len(table), func(i int) bool {
+		return table[i].Score < r.Score
+	})
+	if place >= LeaderboardSize {
+		return -1
+	}
+
+	table = append(table, Record{})
+	copy(table[place+1:], table[place:])
+	table[place] = r
+	if len(table) > LeaderboardSize {
+		table = table[:LeaderboardSize]
+	}
+	lb[level][difficulty] = table
+
+	return place
+}
+
+// Table returns the records of the level on the difficulty sorted by the score.
+func (lb Leaderboard) Table(level, difficulty string) []Record {
+	return lb[level][difficulty]
+}
@@ -0,0 +1,44 @@
+package ingame_test
+
+import (
+	"testing"
+
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+func TestLeaderboard(t *testing.T) {
+	lb := ingame.Leaderboard{}
+
+	if place := lb.Add("level", "Normal", ingame.Record{Nickname: "a", Score: 100}); place != 0 {
+		t.Errorf("first record place = %d, want 0", place)
+	}
+	if place := lb.Add("level", "Normal", ingame.Record{Nickname: "b", Score: 300}); place != 0 {
+		t.Errorf("best record place = %d, want 0", place)
+	}
+	if place := lb.Add("level", "Normal", ingame.Record{Nickname: "c", Score: 100}); place != 2 {
+		t.Errorf("tied record place = %d, want 2", place)
+	}
+	if len(lb.Table("level", "Hard")) != 0 {
+		t.Errorf("tables of the difficulties must be separate")
+	}
+
+	for i := range ingame.LeaderboardSize {
+		lb.Add("level", "Normal", ingame.Record{Score: 200 + i})
+	}
+
+	table := lb.Table("level", "Normal")
+	if len(table) != ingame.LeaderboardSize {
+		t.Fatalf("table size = %d, want %d", len(table), ingame.LeaderboardSize)
+	}
+	for i := 1; i < len(table); i++ {
+		if table[i-1].Score < table[i].Score {
+			t.Errorf("table is not sorted: %d < %d", table[i-1].Score, table[i].Score)
+		}
+	}
+	if table[0].Nickname != "b" {
+		t.Errorf("top record = %q, want b", table[0].Nickname)
+	}
+	if place := lb.Add("level", "Normal", ingame.Record{Score: 1}); place != -1 {
+		t.Errorf("record out of the top place = %d, want -1", place)
+	}
+}
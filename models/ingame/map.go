@@ -1,6 +1,8 @@
 package ingame
 
 import (
+	"slices"
+
 	"github.com/hajimehoshi/ebiten/v2"
 
 	"github.com/gopher-co/td-game/models/config"
@@ -18,18 +20,41 @@ type Map struct {
 	// Projectiles on the map now.
 	Projectiles []*Projectile
 
-	// Path is a path of the map.
-	Path Path
+	// Paths are the named paths of the map.
+	Paths Paths
+
+	// Zones are the zones where towers can or can't be built.
+	Zones Zones
+
+	// Abilities are the player's abilities.
+	Abilities []*Ability
 
 	// Image is an image of the map.
 	Image *ebiten.Image
+
+	// Random is a source of the random numbers of the game on the map.
+	Random *Random
+
+	// grid indexes the enemies for the towers' range queries.
+	grid *Grid
+
+	// subscribers are the handlers of the events of the map.
+	subscribers []Subscriber
 }
 
 // NewMap creates a new entity of Map.
 func NewMap(config *config.Map) *Map {
+	paths := make(Paths)
+	for k, v := range config.AllPaths() {
+		paths[k] = v
+	}
+
 	m := &Map{
-		Path:  config.Path,
-		Image: config.Image(),
+		Paths:  paths,
+		Zones:  Zones{Buildable: config.Buildable, Unbuildable: config.Unbuildable},
+		Image:  config.Image(),
+		Random: NewRandom(0),
+		grid:   NewGrid(),
 	}
 
 	return m
@@ -37,18 +62,39 @@ func NewMap(config *config.Map) *Map {
 
 // Update updates the map.
 func (m *Map) Update() {
+	m.removeDead()
+
 	for _, v := range m.Enemies {
+		dead := v.State.Dead
 		v.Update()
+		if dead || !v.State.Dead {
+			continue
+		}
+		if v.State.PassPath {
+			m.Emit(EnemyLeaked{Enemy: v, Damage: v.DealDamageToPlayer()})
+		} else {
+			m.Emit(EnemyKilled{Enemy: v, Tower: v.State.Killer})
+		}
 	}
 
+	if m.grid == nil {
+		m.grid = NewGrid()
+	}
+	m.grid.Rebuild(m.Enemies)
+
+	m.updateAbilities()
+	m.applyAuras()
+	m.reveal()
+
 	for _, v := range m.Towers {
-		if v.Sold || !v.State.IsTurnedOn {
+		if v.Sold || !v.State.IsTurnedOn || v.Kind != config.KindShooter {
 			continue
 		}
 		v.Update()
-		v.TakeAim(m.Enemies)
-		if p := v.Launch(); p != nil {
+		v.TakeAim(m.grid.Query(v.State.Pos, v.Radius))
+		if p := v.Launch(m.Random); p != nil {
 			m.Projectiles = append(m.Projectiles, p)
+			m.Emit(ProjectileFired{Projectile: p})
 		}
 	}
 
@@ -57,17 +103,121 @@ func (m *Map) Update() {
 	}
 }
 
+// Income returns the money the income towers bring after the wave.
+func (m *Map) Income() int {
+	income := 0
+	for _, t := range m.Towers {
+		if t.Sold || !t.State.IsTurnedOn || t.Kind != config.KindIncome {
+			continue
+		}
+		income += t.Income
+	}
+
+	return income
+}
+
+// UseAbility activates the player's ability with the name aimed at the target.
+// Returns false if there is no such ability or it is on cooldown.
+func (m *Map) UseAbility(name string, target general.Point) bool {
+	a := m.Ability(name)
+	return a != nil && a.Activate(target)
+}
+
+// Ability returns the player's ability with the name or nil.
+func (m *Map) Ability(name string) *Ability {
+	for _, a := range m.Abilities {
+		if a.Name == name {
+			return a
+		}
+	}
+
+	return nil
+}
+
+// updateAbilities updates the abilities of the player and the towers.
+// The airstrikes that have flown hit the enemies.
+func (m *Map) updateAbilities() {
+	for _, a := range m.Abilities {
+		if a.Update() && a.Kind == config.AbilityAirstrike {
+			m.strike(a)
+		}
+	}
+
+	for _, t := range m.Towers {
+		if !t.Sold && t.Ability != nil {
+			t.Ability.Update()
+		}
+	}
+}
+
+// strike deals the damage of the airstrike to the enemies around its target.
+func (m *Map) strike(a *Ability) {
+	for _, e := range m.grid.Query(a.State.Target, a.Radius) {
+		if general.Distance2(a.State.Target, e.State.Pos) <= a.Radius*a.Radius {
+			e.DealDamage(a.Damage)
+		}
+	}
+}
+
+// applyAuras computes the effective stats of the towers with the auras of the aura towers.
+// The radius of an aura doesn't depend on the other auras.
+func (m *Map) applyAuras() {
+	for _, t := range m.Towers {
+		t.auras = t.auras[:0]
+	}
+
+	for _, a := range m.Towers {
+		if a.Sold || !a.State.IsTurnedOn || a.Kind != config.KindAura || a.Aura == nil {
+			continue
+		}
+
+		r := a.upgradedStats().Radius
+		for _, t := range m.Towers {
+			if t != a && !t.Sold && general.Distance2(a.State.Pos, t.State.Pos) <= r*r {
+				t.auras = append(t.auras, a.Aura)
+			}
+		}
+	}
+
+	for _, t := range m.Towers {
+		t.UpdateStats()
+	}
+}
+
+// reveal reveals the hidden enemies in the radius of the detector towers.
+func (m *Map) reveal() {
+	for _, e := range m.Enemies {
+		e.State.Revealed = false
+	}
+
+	for _, d := range m.Towers {
+		if d.Sold || !d.State.IsTurnedOn || d.Kind != config.KindDetector {
+			continue
+		}
+
+		for _, e := range m.grid.Query(d.State.Pos, d.Radius) {
+			if general.Distance2(d.State.Pos, e.State.Pos) <= d.Radius*d.Radius {
+				e.State.Revealed = true
+			}
+		}
+	}
+}
+
 // Draw draws the map.
-func (m *Map) Draw(screen *ebiten.Image) {
+// The moving entities are drawn at the fraction alpha of the way
+// from their positions kept at the previous frame to the current ones.
+func (m *Map) Draw(screen *ebiten.Image, alpha general.Coord) {
 	geom := ebiten.GeoM{}
-	geom.Scale(float64(1500)/float64(m.Image.Bounds().Dx()), float64(1080)/float64(m.Image.Bounds().Dy()))
+	geom.Scale(float64(config.MapWidth)/float64(m.Image.Bounds().Dx()), float64(config.MapHeight)/float64(m.Image.Bounds().Dy()))
 	screen.DrawImage(m.Image, &ebiten.DrawImageOptions{GeoM: geom})
-	m.Path.Draw(screen)
+	for _, p := range m.Paths {
+		p.Draw(screen)
+	}
 	for _, p := range m.Projectiles {
 		if p.dead {
 			continue
 		}
-		p.Draw(screen)
+		p.Draw(screen, alpha)
 	}
 
 	for _, t := range m.Towers {
@@ -79,9 +229,74 @@ func (m *Map) Draw(screen *ebiten.Image) {
 
 	for _, e := range m.Enemies {
 		if !e.State.Dead {
-			e.Draw(screen)
+			e.Draw(screen, alpha)
 		}
 	}
+
+	for _, a := range m.Abilities {
+		a.Draw(screen)
+	}
+}
+
+// KeepPositions keeps the positions of the enemies and the projectiles
+// to draw them between the frames.
+func (m *Map) KeepPositions() {
+	for _, e := range m.Enemies {
+		e.prevPos = e.State.Pos
+	}
+	for _, p := range m.Projectiles {
+		p.prevPos = p.Pos
+	}
+}
+
+// removeDead removes the enemies and the projectiles that died on the previous tick.
+// By this time the events of the dead enemies have already been emitted.
+func (m *Map) removeDead() {
+	m.Enemies = slices.DeleteFunc(m.Enemies, func(e *Enemy) bool {
+		return e.State.Dead
+	})
+	m.Projectiles = slices.DeleteFunc(m.Projectiles, func(p *Projectile) bool {
+		return p.dead
+	})
+}
+
+// Spawn adds the enemy to the map.
+func (m *Map) Spawn(e *Enemy) {
+	e.prevPos = e.State.Pos
+	m.Enemies = append(m.Enemies, e)
+	m.Emit(EnemySpawned{Enemy: e})
+}
+
+// StartWave announces the start of the wave with the number n.
+func (m *Map) StartWave(n int) {
+	m.Emit(WaveStarted{Wave: n})
+}
+
+// ClearWave removes the enemies and the projectiles of the wave with the number n
+// and announces it is cleared.
+func (m *Map) ClearWave(n int) {
+	m.Enemies = []*Enemy{}
+	m.Projectiles = []*Projectile{}
+	m.Emit(WaveCleared{Wave: n})
+}
+
+// Build adds the tower to the map.
+func (m *Map) Build(t *Tower) {
+	t.Index = len(m.Towers)
+	m.Towers = append(m.Towers, t)
+	m.Emit(TowerBuilt{Tower: t})
+}
+
+// Unbuild removes the tower from the map as if it had never been built.
+// It is used to undo the placement of the last built tower.
+func (m *Map) Unbuild(t *Tower) {
+	m.Towers = slices.Delete(m.Towers, t.Index, t.Index+1)
+	m.Emit(TowerUnbuilt{Tower: t})
+}
+
+// CanPlaceTower checks if a new tower can be placed at pos.
+func (m *Map) CanPlaceTower(pos general.Point) bool {
+	return CanPlaceTower(pos, m.Paths, m.Zones, m.Towers)
 }
 
 // AreThereAliveEnemies returns true if there are alive enemies on the map.
@@ -98,6 +313,19 @@ func (m *Map) AreThereAliveEnemies() bool {
 // Path is a struct that represents a path.
 type Path []general.Point
 
+// Paths is a set of the paths by their names.
+type Paths map[string]Path
+
+// Get returns the path by its name.
+// If the name is empty, returns the path with config.DefaultPathName name.
+func (ps Paths) Get(name string) Path {
+	if name == "" {
+		name = config.DefaultPathName
+	}
+
+	return ps[name]
+}
+
 // Draw draws the path.
 func (p Path) Draw(_ *ebiten.Image) {
 	//for i := 0; i < len(p)-1; i++ {
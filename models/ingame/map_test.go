@@ -0,0 +1,329 @@
+package ingame_test
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+// newWave creates n enemies spread over the map.
+func newWave(n int) []*ingame.Enemy {
+	r := rand.New(rand.NewSource(1))
+	path := ingame.Path{{X: 0, Y: 540}, {X: 1500, Y: 540}}
+
+	enemies := make([]*ingame.Enemy, n)
+	for i := range enemies {
+		enemies[i] = &ingame.Enemy{
+			State: ingame.EnemyState{
+				CurrPoint: 0,
+				Pos:       general.Point{X: general.Coord(r.Intn(1500)), Y: general.Coord(r.Intn(1080))},
+				Health:    r.Intn(100) + 1,
+			},
+			Path:      path,
+			MaxHealth: 100,
+			Vrms:      2,
+		}
+	}
+
+	return enemies
+}
+
+// newTowers creates n towers spread over the map.
+func newTowers(n int) []*ingame.Tower {
+	r := rand.New(rand.NewSource(2))
+
+	stats := ingame.Stats{Damage: 10, Radius: 200, SpeedAttack: 20, ProjectileVrms: 10}
+
+	towers := make([]*ingame.Tower, n)
+	for i := range towers {
+		towers[i] = &ingame.Tower{
+			Kind:  config.KindShooter,
+			Stats: stats,
+			Base:  stats,
+			State: ingame.TowerState{
+				IsTurnedOn: true,
+				Pos:        general.Point{X: general.Coord(r.Intn(1500)), Y: general.Coord(r.Intn(1080))},
+				AimType:    ingame.Aim(i % len(ingame.Aims)),
+			},
+		}
+	}
+
+	return towers
+}
+
+func TestGridQuery(t *testing.T) {
+	enemies := newWave(1000)
+	towers := newTowers(50)
+
+	g := ingame.NewGrid()
+	g.Rebuild(enemies)
+
+	for i, tw := range towers {
+		tw.TakeAim(enemies)
+		want := tw.State.Aim
+
+		tw.TakeAim(g.Query(tw.State.Pos, tw.Radius))
+		if tw.State.Aim != want {
+			t.Errorf("tower %d: grid chose %p, linear scan chose %p", i, tw.State.Aim, want)
+		}
+	}
+}
+
+func BenchmarkTakeAim(b *testing.B) {
+	for _, n := range []int{100, 1000, 10000} {
+		enemies := newWave(n)
+		towers := newTowers(50)
+
+		b.Run("linear/"+strconv.Itoa(n), func(b *testing.B) {
+			for range b.N {
+				for _, t := range towers {
+					t.TakeAim(enemies)
+				}
+			}
+		})
+
+		b.Run("grid/"+strconv.Itoa(n), func(b *testing.B) {
+			g := ingame.NewGrid()
+			for range b.N {
+				g.Rebuild(enemies)
+				for _, t := range towers {
+					t.TakeAim(g.Query(t.State.Pos, t.Radius))
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkMapUpdate(b *testing.B) {
+	for _, n := range []int{100, 1000, 10000} {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			m := &ingame.Map{Towers: newTowers(50)}
+			for range b.N {
+				b.StopTimer()
+				m.Enemies = newWave(n)
+				m.Projectiles = nil
+				b.StartTimer()
+
+				m.Update()
+			}
+		})
+	}
+}
+
+func TestSupportTowers(t *testing.T) {
+	shooter := &ingame.Tower{
+		Kind:  config.KindShooter,
+		Base:  ingame.Stats{Damage: 1, Radius: 100, SpeedAttack: 20, ProjectileVrms: 10},
+		State: ingame.TowerState{IsTurnedOn: true, Pos: general.Point{X: 500, Y: 500}},
+	}
+	aura := &ingame.Tower{
+		Kind:  config.KindAura,
+		Base:  ingame.Stats{Radius: 150},
+		Aura:  &ingame.Aura{DeltaDamage: 2, DeltaRadius: 50},
+		State: ingame.TowerState{IsTurnedOn: true, Pos: general.Point{X: 600, Y: 500}},
+	}
+	bank := &ingame.Tower{
+		Kind:  config.KindIncome,
+		Base:  ingame.Stats{Income: 40},
+		State: ingame.TowerState{IsTurnedOn: true, Pos: general.Point{X: 900, Y: 900}},
+	}
+	detector := &ingame.Tower{
+		Kind:  config.KindDetector,
+		Base:  ingame.Stats{Radius: 100},
+		State: ingame.TowerState{Pos: general.Point{X: 400, Y: 400}},
+	}
+
+	path := ingame.Path{{X: 400, Y: 400}, {X: 1400, Y: 400}}
+	spy := &ingame.Enemy{
+		State:  ingame.EnemyState{CurrPoint: -1, Pos: path[0], Health: 100},
+		Path:   path,
+		Vrms:   0.5,
+		Hidden: true,
+	}
+
+	m := &ingame.Map{Towers: []*ingame.Tower{shooter, aura, bank, detector}, Enemies: []*ingame.Enemy{spy}}
+	m.Update()
+
+	if shooter.Damage != 3 || shooter.Radius != 150 {
+		t.Errorf("boosted stats = %d, %v, want 3, 150", shooter.Damage, shooter.Radius)
+	}
+	if shooter.State.Aim != nil {
+		t.Error("the hidden enemy is aimed without a detector")
+	}
+	if m.Income() != 40 {
+		t.Errorf("income = %d, want 40", m.Income())
+	}
+
+	detector.State.IsTurnedOn = true
+	m.Update()
+
+	if shooter.State.Aim != spy {
+		t.Error("the revealed enemy isn't aimed")
+	}
+
+	aura.Sold = true
+	m.Update()
+
+	if shooter.Damage != 1 {
+		t.Errorf("damage after the aura is sold = %d, want 1", shooter.Damage)
+	}
+}
+
+func TestAbilities(t *testing.T) {
+	tower := &ingame.Tower{
+		Kind:    config.KindShooter,
+		Base:    ingame.Stats{Damage: 1, Radius: 100, SpeedAttack: 20, ProjectileVrms: 10},
+		Ability: &ingame.Ability{Kind: config.AbilityOverdrive, Cooldown: 10, Duration: 3, DeltaDamage: 4},
+		State:   ingame.TowerState{IsTurnedOn: true, Pos: general.Point{X: 100, Y: 100}},
+	}
+	airstrike := &ingame.Ability{Name: "Airstrike", Kind: config.AbilityAirstrike, Cooldown: 10, Duration: 2, Damage: 50, Radius: 50}
+
+	path := ingame.Path{{X: 1000, Y: 1000}, {X: 1400, Y: 1000}}
+	near := &ingame.Enemy{State: ingame.EnemyState{CurrPoint: -1, Pos: path[0], Health: 100}, Path: path}
+	far := &ingame.Enemy{State: ingame.EnemyState{CurrPoint: -1, Pos: path[1], Health: 100}, Path: path[1:]}
+
+	m := &ingame.Map{
+		Towers:    []*ingame.Tower{tower},
+		Enemies:   []*ingame.Enemy{near, far},
+		Abilities: []*ingame.Ability{airstrike},
+	}
+
+	if !tower.UseAbility() || tower.UseAbility() {
+		t.Fatal("the overdrive must be used only once per cooldown")
+	}
+	if !m.UseAbility("Airstrike", path[0]) || m.UseAbility("Airstrike", path[0]) {
+		t.Fatal("the airstrike must be used only once per cooldown")
+	}
+
+	m.Update()
+	if tower.Damage != 5 {
+		t.Errorf("overdrive damage = %d, want 5", tower.Damage)
+	}
+	if near.State.Health != 100 {
+		t.Error("the airstrike hit before its flight ended")
+	}
+
+	m.Update()
+	if near.State.Health != 50 || far.State.Health != 100 {
+		t.Errorf("health after the airstrike = %d, %d, want 50, 100", near.State.Health, far.State.Health)
+	}
+
+	m.Update()
+	if tower.Damage != 1 {
+		t.Errorf("damage after the overdrive = %d, want 1", tower.Damage)
+	}
+
+	for range 7 {
+		m.Update()
+	}
+	if !tower.Ability.Ready() || !airstrike.Ready() {
+		t.Error("the abilities aren't ready after the cooldown")
+	}
+}
+
+func TestOverdriveCooldown(t *testing.T) {
+	newTower := func() *ingame.Tower {
+		e := &ingame.Enemy{State: ingame.EnemyState{Pos: general.Point{X: 150, Y: 100}, Health: 100}}
+		return &ingame.Tower{
+			Kind:    config.KindShooter,
+			Base:    ingame.Stats{Damage: 1, Radius: 100, SpeedAttack: 20, ProjectileVrms: 10},
+			Ability: &ingame.Ability{Kind: config.AbilityOverdrive, Cooldown: 10, Duration: 3, DeltaSpeedAttack: 20},
+			State:   ingame.TowerState{IsTurnedOn: true, Pos: general.Point{X: 100, Y: 100}, Aim: e},
+		}
+	}
+
+	normal := newTower()
+	normal.UpdateStats()
+	normal.Launch(ingame.NewRandom(1))
+
+	boosted := newTower()
+	if !boosted.UseAbility() {
+		t.Fatal("the overdrive isn't used")
+	}
+	boosted.UpdateStats()
+	boosted.Launch(ingame.NewRandom(1))
+
+	if boosted.State.CoolDown >= normal.State.CoolDown {
+		t.Errorf("cooldown with the overdrive = %d, without = %d, want shorter", boosted.State.CoolDown, normal.State.CoolDown)
+	}
+	if boosted.State.CoolDown != 30 {
+		t.Errorf("cooldown with the overdrive = %d, want 30", boosted.State.CoolDown)
+	}
+}
+
+func TestVeterancy(t *testing.T) {
+	tower := &ingame.Tower{
+		Kind:  config.KindShooter,
+		Base:  ingame.Stats{Damage: 10, Radius: 200, SpeedAttack: 1200, ProjectileVrms: 1000},
+		Ranks: []*ingame.Rank{{Name: "Veteran", Kills: 1, Damage: 20, DeltaDamage: 5}},
+		State: ingame.TowerState{IsTurnedOn: true, Pos: general.Point{X: 100, Y: 100}},
+	}
+
+	path := ingame.Path{{X: 150, Y: 100}, {X: 150, Y: 100}}
+	e := &ingame.Enemy{State: ingame.EnemyState{CurrPoint: -1, Pos: path[0], Health: 25}, Path: path}
+
+	m := &ingame.Map{Towers: []*ingame.Tower{tower}, Enemies: []*ingame.Enemy{e}}
+	for range 10 {
+		m.Update()
+	}
+
+	if tower.Kills != 1 || tower.DamageDealt != 25 {
+		t.Fatalf("kills, damage = %d, %d, want 1, 25", tower.Kills, tower.DamageDealt)
+	}
+	if tower.Rank() != 0 || tower.Damage != 15 {
+		t.Errorf("rank, damage = %d, %d, want 0, 15", tower.Rank(), tower.Damage)
+	}
+}
+
+func TestBossPhases(t *testing.T) {
+	path := ingame.Path{{X: 0, Y: 100}, {X: 1000, Y: 100}}
+	boss := ingame.NewEnemy(&config.Enemy{
+		Name:      "#ff0000",
+		MaxHealth: 100,
+		Vrms:      1,
+		Boss:      true,
+		Phases: []config.Phase{
+			{HealthPercent: 50, SpeedMultiplier: 2, Adds: []string{"#00ff00", "#00ff00"}},
+			{HealthPercent: 25, Immune: 3},
+		},
+	}, path)
+	adds := map[string]*config.Enemy{"#00ff00": {Name: "#00ff00", MaxHealth: 1, Vrms: 1}}
+
+	m := &ingame.Map{Enemies: []*ingame.Enemy{boss}}
+	for range 10 {
+		m.Update()
+	}
+
+	boss.DealDamage(50)
+	m.Update()
+	m.SpawnAdds(adds)
+
+	if boss.Vrms != 2 {
+		t.Errorf("speed after the first phase = %v, want 2", boss.Vrms)
+	}
+	if len(m.Enemies) != 3 || m.Enemies[1].State.Pos != boss.State.Pos {
+		t.Fatalf("adds aren't spawned at the boss position")
+	}
+	if m.Boss() != boss {
+		t.Error("the boss isn't found")
+	}
+
+	boss.DealDamage(30)
+	m.Update()
+	boss.DealDamage(20)
+	if boss.State.Health != 20 {
+		t.Errorf("the immune boss took damage, health = %d", boss.State.Health)
+	}
+
+	for range 3 {
+		m.Update()
+	}
+	boss.DealDamage(20)
+	if boss.State.Health != 0 {
+		t.Errorf("the boss is still immune, health = %d", boss.State.Health)
+	}
+}
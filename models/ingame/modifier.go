@@ -0,0 +1,80 @@
+package ingame
+
+import (
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/general"
+)
+
+// Stats are the stats of the tower that may be modified.
+type Stats struct {
+	// Damage is a damage of the tower.
+	Damage int
+
+	// Radius is a radius of the tower.
+	Radius general.Coord
+
+	// SpeedAttack is a speed of the tower's attack.
+	SpeedAttack general.Frames
+
+	// ProjectileVrms is a root mean square speed of the tower's projectile.
+	ProjectileVrms general.Coord
+
+	// Income is a money the tower brings after each wave.
+	Income int
+}
+
+// Modifier is a stage of the pipeline that computes the effective stats of the tower.
+//
+// The effective stats are computed from the base stats of the tower
+// by its bought upgrades and its veterancy rank, then by the auras of the towers nearby
+// and then by the tower's active ability.
+type Modifier interface {
+	// Modify returns the stats s modified.
+	Modify(s Stats) Stats
+}
+
+// Modify adds the deltas of the upgrade to the stats.
+func (u *Upgrade) Modify(s Stats) Stats {
+	s.Damage += u.DeltaDamage
+	s.Radius += u.DeltaRadius
+	s.SpeedAttack += u.DeltaSpeedAttack
+	s.ProjectileVrms += u.DeltaProjectileVrms
+	s.Income += u.DeltaIncome
+
+	return s
+}
+
+// Aura is an effect of the aura tower on the towers in its radius.
+type Aura struct {
+	// DeltaDamage is a delta of the damage.
+	DeltaDamage int
+
+	// DeltaRadius is a delta of the radius.
+	DeltaRadius general.Coord
+
+	// DeltaSpeedAttack is a delta of the speed of the attack.
+	DeltaSpeedAttack general.Frames
+}
+
+// NewAura creates a new Aura.
+// Returns nil if the config is nil.
+func NewAura(config *config.Aura) *Aura {
+	if config == nil {
+		return nil
+	}
+
+	return &Aura{
+		DeltaDamage:      config.DeltaDamage,
+		DeltaRadius:      config.DeltaRadius,
+		DeltaSpeedAttack: config.DeltaSpeedAttack,
+	}
+}
+
+// Modify adds the deltas of the aura to the stats.
+func (a *Aura) Modify(s Stats) Stats {
+	s.Damage += a.DeltaDamage
+	s.Radius += a.DeltaRadius
+	s.SpeedAttack += a.DeltaSpeedAttack
+
+	return s
+}
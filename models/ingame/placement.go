@@ -0,0 +1,74 @@
+package ingame
+
+import (
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/general"
+)
+
+// Zones contains the zones of the map where towers can or can't be built.
+type Zones struct {
+	// Buildable is a list of zones where towers can be built.
+	// If it is empty, towers can be built anywhere on the map.
+	Buildable []general.Polygon
+
+	// Unbuildable is a list of zones where towers can't be built.
+	Unbuildable []general.Polygon
+}
+
+// Allows checks if the zones allow to build a tower at pos.
+func (z Zones) Allows(pos general.Point) bool {
+	for _, pg := range z.Unbuildable {
+		if pg.Contains(pos) {
+			return false
+		}
+	}
+
+	if len(z.Buildable) == 0 {
+		return true
+	}
+
+	for _, pg := range z.Buildable {
+		if pg.Contains(pos) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanPlaceTower checks if a new tower can be placed at pos.
+//
+// The tower's footprint must be inside the map and mustn't overlap
+// the paths and the other towers. Sold towers are ignored.
+// The tower's center must be allowed by the zones.
+func CanPlaceTower(pos general.Point, paths Paths, zones Zones, towers []*Tower) bool {
+	return InBounds(pos) &&
+		!CheckCollisionPaths(pos, paths) &&
+		!CheckCollisionTowers(pos, towers) &&
+		zones.Allows(pos)
+}
+
+// InBounds checks if the footprint of the tower at pos is inside the map.
+func InBounds(pos general.Point) bool {
+	const half = config.TowerImageWidth / 2
+
+	return pos.X >= half && pos.X <= config.MapWidth-half &&
+		pos.Y >= half && pos.Y <= config.MapHeight-half
+}
+
+// CheckCollisionTowers checks if the footprint of the tower at pos overlaps any of the towers.
+func CheckCollisionTowers(pos general.Point, towers []*Tower) bool {
+	for _, t := range towers {
+		if t.Sold {
+			continue
+		}
+
+		dx, dy := pos.X-t.State.Pos.X, pos.Y-t.State.Pos.Y
+		if dx < config.TowerImageWidth && -dx < config.TowerImageWidth &&
+			dy < config.TowerImageWidth && -dy < config.TowerImageWidth {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,40 @@
+package ingame_test
+
+import (
+	"testing"
+
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+func TestCanPlaceTower(t *testing.T) {
+	paths := ingame.Paths{"main": {{X: 0, Y: 540}, {X: 1500, Y: 540}}}
+	zones := ingame.Zones{
+		Buildable:   []general.Polygon{{{X: 0, Y: 0}, {X: 1000, Y: 0}, {X: 1000, Y: 1080}, {X: 0, Y: 1080}}},
+		Unbuildable: []general.Polygon{{{X: 0, Y: 0}, {X: 300, Y: 0}, {X: 300, Y: 300}, {X: 0, Y: 300}}},
+	}
+	towers := []*ingame.Tower{
+		{State: ingame.TowerState{Pos: general.Point{X: 500, Y: 200}}},
+		{State: ingame.TowerState{Pos: general.Point{X: 800, Y: 200}}, Sold: true},
+	}
+
+	tests := []struct {
+		name string
+		pos  general.Point
+		want bool
+	}{
+		{"free", general.Point{X: 500, Y: 800}, true},
+		{"path", general.Point{X: 500, Y: 550}, false},
+		{"edge", general.Point{X: 20, Y: 800}, false},
+		{"tower", general.Point{X: 550, Y: 250}, false},
+		{"sold tower", general.Point{X: 800, Y: 200}, true},
+		{"unbuildable", general.Point{X: 200, Y: 200}, false},
+		{"not buildable", general.Point{X: 1200, Y: 800}, false},
+	}
+
+	for _, tt := range tests {
+		if got := ingame.CanPlaceTower(tt.pos, paths, zones, towers); got != tt.want {
+			t.Errorf("%s: CanPlaceTower(%v) = %v, want %v", tt.name, tt.pos, got, tt.want)
+		}
+	}
+}
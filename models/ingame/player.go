@@ -13,3 +13,14 @@ type PlayerMapState struct {
 func (s *PlayerMapState) Dead() bool {
 	return s.Health == 0
 }
+
+// Handle awards the money for the killed enemies and takes the health for the leaked ones.
+// It is a Subscriber of the map of the game.
+func (s *PlayerMapState) Handle(e Event) {
+	switch e := e.(type) {
+	case EnemyKilled:
+		s.Money += e.Enemy.MoneyAward
+	case EnemyLeaked:
+		s.Health = max(s.Health-e.Damage, 0)
+	}
+}
@@ -6,10 +6,89 @@ import (
 	"github.com/gopher-co/td-game/models/config"
 )
 
+// Difficulties is a set of the names of the difficulties.
+type Difficulties map[string]struct{}
+
+// DefaultNickname is a nickname of the player who hasn't set one.
+const DefaultNickname = "Player"
+
 // PlayerState is a struct that represents a state of the player.
 type PlayerState struct {
-	// LevelsComplete is a set of levels that player has completed.
-	LevelsComplete map[string]struct{} `json:"levels_complete"`
+	// Nickname is a nickname of the player shown in the leaderboard.
+	Nickname string `json:"nickname,omitempty"`
+
+	// LevelsComplete is a set of levels that player has completed
+	// with the difficulties they have been completed on.
+	LevelsComplete map[string]Difficulties `json:"levels_complete"`
+
+	// BestWave is the best wave reached by the player on each level.
+	BestWave map[string]int `json:"best_wave"`
+
+	// BestScore is the best score of the player on each level.
+	BestScore map[string]int `json:"best_score"`
+
+	// Stars is the most stars the player has got on each level.
+	Stars map[string]int `json:"stars"`
+}
+
+// Nick returns the nickname of the player or DefaultNickname if it's empty.
+func (ps *PlayerState) Nick() string {
+	if ps.Nickname == "" {
+		return DefaultNickname
+	}
+
+	return ps.Nickname
+}
+
+// CompleteLevel marks the level as completed on the difficulty.
+func (ps *PlayerState) CompleteLevel(level, difficulty string) {
+	if ps.LevelsComplete == nil {
+		ps.LevelsComplete = make(map[string]Difficulties)
+	}
+	if ps.LevelsComplete[level] == nil {
+		ps.LevelsComplete[level] = make(Difficulties)
+	}
+
+	ps.LevelsComplete[level][difficulty] = struct{}{}
+}
+
+// UpdateScore sets the best score and the stars of the level if they are better.
+// Returns true if any of them is changed.
+func (ps *PlayerState) UpdateScore(level string, score, stars int) bool {
+	changed := false
+
+	if score > ps.BestScore[level] {
+		if ps.BestScore == nil {
+			ps.BestScore = make(map[string]int)
+		}
+		ps.BestScore[level] = score
+		changed = true
+	}
+
+	if stars > ps.Stars[level] {
+		if ps.Stars == nil {
+			ps.Stars = make(map[string]int)
+		}
+		ps.Stars[level] = stars
+		changed = true
+	}
+
+	return changed
+}
+
+// UpdateBestWave sets the best wave of the level if the wave is better.
+// Returns true if the best wave is changed.
+func (ps *PlayerState) UpdateBestWave(level string, wave int) bool {
+	if wave <= ps.BestWave[level] {
+		return false
+	}
+
+	if ps.BestWave == nil {
+		ps.BestWave = make(map[string]int)
+	}
+	ps.BestWave[level] = wave
+
+	return true
 }
 
 // Valid returns an error if the player's state is not valid.
@@ -20,5 +99,13 @@ func (ps *PlayerState) Valid(levels map[string]*config.Level) error {
 		}
 	}
 
+	for _, m := range []map[string]int{ps.BestWave, ps.BestScore, ps.Stars} {
+		for k := range m {
+			if _, ok := levels[k]; !ok {
+				return fmt.Errorf("level %v doesn't exist", k)
+			}
+		}
+	}
+
 	return nil
 }
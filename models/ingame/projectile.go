@@ -28,17 +28,30 @@ type Projectile struct {
 	// Damage is a damage of the projectile.
 	Damage int
 
+	// Critical is a flag that shows if the projectile deals the critical damage.
+	Critical bool
+
 	// TTL is a time to live of the projectile.
 	TTL general.Frames
 
 	// TargetEnemy is an enemy that the projectile is flying to.
 	TargetEnemy *Enemy
 
+	// Source is a tower that launched the projectile.
+	Source *Tower
+
 	// Image is an image of the projectile.
 	Image *ebiten.Image
 
 	// dead is a flag that shows if the projectile is dead.
 	dead bool
+
+	// evaded is a flag that shows if the target evades the projectile.
+	evaded bool
+
+	// prevPos is a position of the projectile at the previous frame.
+	// The projectile is drawn between it and Pos.
+	prevPos general.Point
 }
 
 // Update updates the projectile.
@@ -50,10 +63,11 @@ func (p *Projectile) Update() {
 	}
 }
 
-// Draw draws the projectile.
-func (p *Projectile) Draw(screen *ebiten.Image) {
+// Draw draws the projectile at the fraction alpha of the way from the previous frame to the current one.
+func (p *Projectile) Draw(screen *ebiten.Image, alpha general.Coord) {
+	pos := p.prevPos.Lerp(p.Pos, alpha)
 	geom := ebiten.GeoM{}
-	geom.Translate(float64(p.Pos.X-float32(p.Image.Bounds().Dx()/2)), float64(p.Pos.Y-float32(p.Image.Bounds().Dy()/2)))
+	geom.Translate(float64(pos.X-float32(p.Image.Bounds().Dx()/2)), float64(pos.Y-float32(p.Image.Bounds().Dy()/2)))
 	screen.DrawImage(p.Image, &ebiten.DrawImageOptions{GeoM: geom})
 }
 
@@ -65,6 +79,23 @@ func (p *Projectile) move() {
 }
 
 // EnemyHit checks if the projectile hit the enemy and returns true if it is.
+// The source tower gains the experience for the damage dealt.
+// The evaded projectile deals no damage.
 func (p *Projectile) EnemyHit() {
-	p.TargetEnemy.DealDamage(p.TargetEnemy.FinalDamage(p.Type, p.Damage))
+	if p.evaded {
+		return
+	}
+
+	e := p.TargetEnemy
+	health := e.State.Health
+	e.DealDamage(e.FinalDamage(p.Type, p.Damage))
+
+	killed := health > 0 && e.State.Health == 0
+	if killed {
+		e.State.Killer = p.Source
+	}
+
+	if p.Source != nil {
+		p.Source.gainExperience(health-e.State.Health, killed)
+	}
 }
@@ -0,0 +1,51 @@
+package ingame
+
+import (
+	"math/rand/v2"
+)
+
+// randomStream is a stream of the PCG generator used by Random.
+// It differs from the streams of the endless waves generated from the same seed.
+const randomStream = ^uint64(0)
+
+// Random is a source of the random numbers of the simulation.
+//
+// It is seeded once per game, so the games with the same seed and the same actions
+// go the same way, e.g. the replays and the games of the co-op players.
+// The numbers are drawn in the order the entities are updated in.
+type Random struct {
+	rng *rand.Rand
+
+	// src is the generator of rng, its state is saved with the game.
+	src *rand.PCG
+}
+
+// NewRandom creates a new entity of Random.
+func NewRandom(seed uint64) *Random {
+	src := rand.NewPCG(seed, randomStream)
+	return &Random{rng: rand.New(src), src: src}
+}
+
+// MarshalBinary returns the state of the generator.
+func (r *Random) MarshalBinary() ([]byte, error) {
+	return r.src.MarshalBinary()
+}
+
+// UnmarshalBinary restores the state of the generator returned by MarshalBinary.
+func (r *Random) UnmarshalBinary(data []byte) error {
+	return r.src.UnmarshalBinary(data)
+}
+
+// Chance returns true with the probability of percent / 100.
+// No number is drawn if the result is certain, so the features that aren't
+// used in the game don't change the numbers drawn for the others.
+func (r *Random) Chance(percent int) bool {
+	if percent <= 0 {
+		return false
+	}
+	if percent >= 100 {
+		return true
+	}
+
+	return r.rng.IntN(100) < percent
+}
@@ -0,0 +1,67 @@
+package ingame_test
+
+import (
+	"testing"
+
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+func TestRandom(t *testing.T) {
+	r1, r2 := ingame.NewRandom(42), ingame.NewRandom(42)
+	hits := 0
+	for i := range 1000 {
+		// the certain chances don't draw the numbers
+		if r1.Chance(0) || !r1.Chance(100) {
+			t.Fatal("certain chance failed")
+		}
+
+		a, b := r1.Chance(30), r2.Chance(30)
+		if a != b {
+			t.Fatalf("roll %d: %v and %v with the same seed", i, a, b)
+		}
+		if a {
+			hits++
+		}
+	}
+
+	if hits < 200 || hits > 400 {
+		t.Errorf("30%% chance hit %d times out of 1000", hits)
+	}
+}
+
+func TestCriticalHit(t *testing.T) {
+	tests := []struct {
+		name    string
+		crit    int
+		evasion int
+		health  int
+	}{
+		{name: "normal", health: 90},
+		{name: "critical", crit: 100, health: 75},
+		{name: "evaded", crit: 100, evasion: 100, health: 100},
+	}
+
+	for _, tt := range tests {
+		e := &ingame.Enemy{
+			State:     ingame.EnemyState{Pos: general.Point{X: 100}, Health: 100},
+			MaxHealth: 100,
+			Evasion:   tt.evasion,
+		}
+		tw := &ingame.Tower{
+			Stats:      ingame.Stats{Damage: 10, SpeedAttack: 20, ProjectileVrms: 5},
+			CritChance: tt.crit,
+			CritDamage: 250,
+			State:      ingame.TowerState{Aim: e},
+		}
+
+		p := tw.Launch(ingame.NewRandom(1))
+		if p.Critical != (tt.crit > 0) {
+			t.Errorf("%s: critical = %v", tt.name, p.Critical)
+		}
+		p.EnemyHit()
+		if e.State.Health != tt.health {
+			t.Errorf("%s: health = %d, want %d", tt.name, e.State.Health, tt.health)
+		}
+	}
+}
@@ -0,0 +1,357 @@
+package ingame
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/general"
+)
+
+// MapSave is a saved state of the entities on the map.
+// The entities refer to each other by their indexes in the lists of the map, -1 for none.
+type MapSave struct {
+	// Towers are the towers on the map including the sold ones.
+	Towers []TowerSave `json:"towers"`
+
+	// Enemies are the enemies on the map including the ones that have died on the last tick.
+	Enemies []EnemySave `json:"enemies"`
+
+	// Projectiles are the projectiles on the map including the ones that have hit on the last tick.
+	Projectiles []ProjectileSave `json:"projectiles"`
+
+	// Abilities are the states of the player's abilities.
+	Abilities []AbilityState `json:"abilities"`
+
+	// Random is the state of the source of the random numbers.
+	Random []byte `json:"random"`
+}
+
+// TowerSave is a saved state of the tower.
+type TowerSave struct {
+	// Name is a name of the tower.
+	Name string `json:"name"`
+
+	// Pos is a position of the tower.
+	Pos general.Point `json:"pos"`
+
+	// AimType is a type of the aim of the tower.
+	AimType Aim `json:"aim_type"`
+
+	// IsTurnedOn is a flag that shows if the tower is turned on.
+	IsTurnedOn bool `json:"is_turned_on"`
+
+	// CoolDown is a cool down of the tower.
+	CoolDown general.Frames `json:"cooldown"`
+
+	// Aim is an index of the enemy the tower is aiming at.
+	Aim int `json:"aim"`
+
+	// Upgrades are the IDs of the bought upgrades in the order of buying.
+	Upgrades []string `json:"upgrades"`
+
+	// Stats are the effective stats of the tower.
+	Stats Stats `json:"stats"`
+
+	// Kills is a number of the enemies killed by the tower.
+	Kills int `json:"kills"`
+
+	// DamageDealt is a damage dealt by the tower's projectiles.
+	DamageDealt int `json:"damage_dealt"`
+
+	// Sold is a flag that shows if the tower is sold.
+	Sold bool `json:"sold"`
+
+	// Ability is a state of the tower's ability or nil if the tower has none.
+	Ability *AbilityState `json:"ability,omitempty"`
+}
+
+// EnemySave is a saved state of the enemy.
+type EnemySave struct {
+	// Name is a name of the enemy.
+	Name string `json:"name"`
+
+	// State is a state of the enemy.
+	State EnemyState `json:"state"`
+
+	// Killer is an index of the tower that has dealt the lethal damage.
+	Killer int `json:"killer"`
+
+	// Path is a path of the enemy.
+	Path Path `json:"path"`
+
+	// MaxHealth is a maximal health of the enemy changed by the wave.
+	MaxHealth int `json:"max_health"`
+
+	// Vrms is a speed of the enemy changed by its phases.
+	Vrms general.Coord `json:"vrms"`
+
+	// Strengths are the strengths of the enemy changed by its phases.
+	Strengths map[general.TypeAttack]Strength `json:"strengths"`
+}
+
+// ProjectileSave is a saved state of the projectile.
+type ProjectileSave struct {
+	// Pos is a position of the projectile.
+	Pos general.Point `json:"pos"`
+
+	// Vrms is a root mean square speed of the projectile.
+	Vrms general.Coord `json:"vrms"`
+
+	// Vx is a speed of the projectile on the X axis.
+	Vx general.Coord `json:"vx"`
+
+	// Vy is a speed of the projectile on the Y axis.
+	Vy general.Coord `json:"vy"`
+
+	// Type is a type of the projectile.
+	Type general.TypeAttack `json:"type"`
+
+	// Damage is a damage of the projectile.
+	Damage int `json:"damage"`
+
+	// Critical is a flag that shows if the projectile deals the critical damage.
+	Critical bool `json:"critical"`
+
+	// TTL is a time to live of the projectile.
+	TTL general.Frames `json:"ttl"`
+
+	// Target is an index of the enemy the projectile is flying to.
+	Target int `json:"target"`
+
+	// Source is an index of the tower that launched the projectile.
+	Source int `json:"source"`
+
+	// Dead is a flag that shows if the projectile is dead.
+	Dead bool `json:"dead"`
+
+	// Evaded is a flag that shows if the target evades the projectile.
+	Evaded bool `json:"evaded"`
+}
+
+// Save returns the state of the entities on the map.
+func (m *Map) Save() (MapSave, error) {
+	random, err := m.Random.MarshalBinary()
+	if err != nil {
+		return MapSave{}, fmt.Errorf("random numbers not saved: %w", err)
+	}
+
+	enemies := indexes(m.Enemies)
+	towers := indexes(m.Towers)
+	s := MapSave{
+		Towers:      make([]TowerSave, len(m.Towers)),
+		Enemies:     make([]EnemySave, len(m.Enemies)),
+		Projectiles: make([]ProjectileSave, len(m.Projectiles)),
+		Abilities:   make([]AbilityState, len(m.Abilities)),
+		Random:      random,
+	}
+
+	for i, t := range m.Towers {
+		ts := TowerSave{
+			Name:        t.Name,
+			Pos:         t.State.Pos,
+			AimType:     t.State.AimType,
+			IsTurnedOn:  t.State.IsTurnedOn,
+			CoolDown:    t.State.CoolDown,
+			Aim:         index(enemies, t.State.Aim),
+			Upgrades:    make([]string, len(t.Bought)),
+			Stats:       t.Stats,
+			Kills:       t.Kills,
+			DamageDealt: t.DamageDealt,
+			Sold:        t.Sold,
+		}
+		for j, u := range t.Bought {
+			ts.Upgrades[j] = u.ID
+		}
+		if t.Ability != nil {
+			ts.Ability = &AbilityState{}
+			*ts.Ability = t.Ability.State
+		}
+		s.Towers[i] = ts
+	}
+
+	for i, e := range m.Enemies {
+		es := EnemySave{
+			Name:      e.Name,
+			State:     e.State,
+			Killer:    index(towers, e.State.Killer),
+			Path:      e.Path,
+			MaxHealth: e.MaxHealth,
+			Vrms:      e.Vrms,
+			Strengths: maps.Clone(e.Strengths),
+		}
+		es.State.Adds = slices.Clone(e.State.Adds)
+		es.State.Killer = nil
+		s.Enemies[i] = es
+	}
+
+	for i, p := range m.Projectiles {
+		s.Projectiles[i] = ProjectileSave{
+			Pos:      p.Pos,
+			Vrms:     p.Vrms,
+			Vx:       p.Vx,
+			Vy:       p.Vy,
+			Type:     p.Type,
+			Damage:   p.Damage,
+			Critical: p.Critical,
+			TTL:      p.TTL,
+			Target:   index(enemies, p.TargetEnemy),
+			Source:   index(towers, p.Source),
+			Dead:     p.dead,
+			Evaded:   p.evaded,
+		}
+	}
+
+	for i, a := range m.Abilities {
+		s.Abilities[i] = a.State
+	}
+
+	return s, nil
+}
+
+// Restore brings the new map to the saved state.
+// The towers and the enemies are created from their configs, no events are emitted.
+//
+// Returns an error if the save doesn't match the configs or refers to the entities that don't exist.
+func (m *Map) Restore(s MapSave, towers map[string]*config.Tower, enemies map[string]*config.Enemy) error {
+	if len(m.Towers) != 0 || len(m.Enemies) != 0 {
+		return errors.New("only a new map can be restored")
+	}
+	if len(s.Abilities) != len(m.Abilities) {
+		return fmt.Errorf("%d abilities are saved, the map has %d", len(s.Abilities), len(m.Abilities))
+	}
+
+	m.Towers = make([]*Tower, len(s.Towers))
+	for i, ts := range s.Towers {
+		cfg, ok := towers[ts.Name]
+		if !ok {
+			return fmt.Errorf("tower %s doesn't exist", ts.Name)
+		}
+
+		t := NewTower(cfg, ts.Pos)
+		for _, id := range ts.Upgrades {
+			if t.Upgrade(id, nil) == nil {
+				return fmt.Errorf("tower %s has no upgrade %s", ts.Name, id)
+			}
+		}
+		t.Index = i
+		t.State.AimType = ts.AimType
+		t.State.IsTurnedOn = ts.IsTurnedOn
+		t.State.CoolDown = ts.CoolDown
+		t.Stats = ts.Stats
+		t.Kills = ts.Kills
+		t.DamageDealt = ts.DamageDealt
+		t.Sold = ts.Sold
+		if (t.Ability == nil) != (ts.Ability == nil) {
+			return fmt.Errorf("the ability of tower %s doesn't match its config", ts.Name)
+		}
+		if t.Ability != nil {
+			t.Ability.State = *ts.Ability
+		}
+		m.Towers[i] = t
+	}
+
+	m.Enemies = make([]*Enemy, len(s.Enemies))
+	for i, es := range s.Enemies {
+		cfg, ok := enemies[es.Name]
+		if !ok {
+			return fmt.Errorf("enemy %s doesn't exist", es.Name)
+		}
+		if len(es.Path) == 0 {
+			return fmt.Errorf("enemy %s has no path", es.Name)
+		}
+
+		e := NewEnemy(cfg, es.Path)
+		e.State = es.State
+		e.State.Adds = slices.Clone(es.State.Adds)
+		e.MaxHealth = es.MaxHealth
+		e.Vrms = es.Vrms
+		e.Strengths = maps.Clone(es.Strengths)
+		e.prevPos = e.State.Pos
+		m.Enemies[i] = e
+	}
+
+	m.Projectiles = make([]*Projectile, len(s.Projectiles))
+	for i, ps := range s.Projectiles {
+		source, err := at(m.Towers, ps.Source)
+		if err != nil {
+			return fmt.Errorf("projectile %d: %w", i, err)
+		}
+		target, err := at(m.Enemies, ps.Target)
+		if err != nil {
+			return fmt.Errorf("projectile %d: %w", i, err)
+		}
+		if source == nil || target == nil {
+			return fmt.Errorf("projectile %d has no source or target", i)
+		}
+
+		m.Projectiles[i] = &Projectile{
+			Pos:         ps.Pos,
+			Vrms:        ps.Vrms,
+			Vx:          ps.Vx,
+			Vy:          ps.Vy,
+			Type:        ps.Type,
+			Damage:      ps.Damage,
+			Critical:    ps.Critical,
+			TTL:         ps.TTL,
+			TargetEnemy: target,
+			Source:      source,
+			Image:       source.ProjectileImage,
+			dead:        ps.Dead,
+			evaded:      ps.Evaded,
+			prevPos:     ps.Pos,
+		}
+	}
+
+	// the links are restored when all the entities exist
+	var err error
+	for i, ts := range s.Towers {
+		if m.Towers[i].State.Aim, err = at(m.Enemies, ts.Aim); err != nil {
+			return fmt.Errorf("tower %d: %w", i, err)
+		}
+	}
+	for i, es := range s.Enemies {
+		if m.Enemies[i].State.Killer, err = at(m.Towers, es.Killer); err != nil {
+			return fmt.Errorf("enemy %d: %w", i, err)
+		}
+	}
+
+	for i, a := range m.Abilities {
+		a.State = s.Abilities[i]
+	}
+
+	return m.Random.UnmarshalBinary(s.Random)
+}
+
+// indexes returns the indexes of the entities of the list.
+func indexes[T any](list []*T) map[*T]int {
+	idx := make(map[*T]int, len(list))
+	for i, v := range list {
+		idx[v] = i
+	}
+
+	return idx
+}
+
+// index returns the index of the entity v or -1 if it's nil or not in the list.
+func index[T any](idx map[*T]int, v *T) int {
+	if i, ok := idx[v]; ok && v != nil {
+		return i
+	}
+
+	return -1
+}
+
+// at returns the entity of the list at the index or nil if the index is -1.
+func at[T any](list []*T, i int) (*T, error) {
+	if i == -1 {
+		return nil, nil
+	}
+	if i < 0 || i >= len(list) {
+		return nil, fmt.Errorf("index %d is out of range of %d entities", i, len(list))
+	}
+
+	return list[i], nil
+}
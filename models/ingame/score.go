@@ -0,0 +1,39 @@
+package ingame
+
+import (
+	"github.com/gopher-co/td-game/models/general"
+)
+
+// Score is a result of the game the points are computed from.
+type Score struct {
+	// Health is a health of the player left.
+	Health int
+
+	// Money is a money of the player left.
+	Money int
+
+	// Time is a time the game has taken.
+	Time general.Frames
+
+	// TowersSold is a number of the towers sold during the game.
+	TowersSold int
+}
+
+const (
+	// pointsPerHealth is a number of the points for each health point left.
+	pointsPerHealth = 100
+
+	// pointsPerSecond is a number of the points taken for each second of the game.
+	pointsPerSecond = 1
+
+	// pointsPerSold is a number of the points taken for each tower sold.
+	pointsPerSold = 50
+)
+
+// Points returns the points of the score.
+// The player gets the points for the health and the money left
+// and loses them for the time taken and the towers sold.
+func (s Score) Points() int {
+	p := s.Health*pointsPerHealth + s.Money - s.Time/60*pointsPerSecond - s.TowersSold*pointsPerSold
+	return max(p, 0)
+}
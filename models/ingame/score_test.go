@@ -0,0 +1,22 @@
+package ingame_test
+
+import (
+	"testing"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+func TestScore(t *testing.T) {
+	s := ingame.Score{Health: 80, Money: 500, Time: 600, TowersSold: 2}
+	if p := s.Points(); p != 80*100+500-10-2*50 {
+		t.Errorf("points = %d, want %d", p, 80*100+500-10-2*50)
+	}
+
+	level := &config.Level{Stars: []int{5000, 8000, 10000}}
+	for score, want := range map[int]int{0: 1, 5000: 1, 8390: 2, 10000: 3} {
+		if got := level.StarsFor(score); got != want {
+			t.Errorf("stars for %d = %d, want %d", score, got, want)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package ingame
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/gopher-co/td-game/models/general"
+)
+
+// Aim is a type that represents the enemy that tower attacks.
+type Aim int
+
+const (
+	// First is a type of aim that represents the first enemy.
+	First = Aim(iota)
+
+	// Weakest is a type of aim that represents the weakest enemy.
+	Weakest
+
+	// Strongest is a type of aim that represents the strongest enemy.
+	Strongest
+
+	// Last is a type of aim that represents the last enemy on the path.
+	Last
+
+	// Closest is a type of aim that represents the enemy closest to the tower.
+	Closest
+
+	// Fastest is a type of aim that represents the fastest enemy.
+	Fastest
+
+	// MostDamaged is a type of aim that represents the enemy
+	// that has lost the biggest part of its health.
+	MostDamaged
+
+	// Armored is a type of aim that represents the enemy
+	// that resists the tower's attack the most.
+	Armored
+
+	// Richest is a type of aim that represents the enemy with the highest bounty.
+	Richest
+
+	// Vulnerable is a type of aim that represents the enemy
+	// that is the most vulnerable to the tower's attack.
+	Vulnerable
+)
+
+// Aims is a list of all the aims in the order they are shown to the player.
+var Aims = []Aim{First, Last, Strongest, Weakest, Closest, Fastest, MostDamaged, Armored, Richest, Vulnerable}
+
+// aimNames contains the names of the aims.
+var aimNames = map[Aim]string{
+	First:       "First",
+	Weakest:     "Weak",
+	Strongest:   "Strong",
+	Last:        "Last",
+	Closest:     "Close",
+	Fastest:     "Fast",
+	MostDamaged: "Damaged",
+	Armored:     "Armored",
+	Richest:     "Rich",
+	Vulnerable:  "Vulnerable",
+}
+
+// String returns the name of the aim.
+func (a Aim) String() string {
+	return aimNames[a]
+}
+
+// Targeting is a strategy that chooses the enemy the tower attacks.
+type Targeting interface {
+	// Choose returns the enemy the tower t should attack.
+	// All the enemies passed are alive and in the tower's range,
+	// there is at least one of them.
+	Choose(t *Tower, enemies []*Enemy) *Enemy
+}
+
+// TargetingFunc is an adapter that allows to use functions as Targeting.
+type TargetingFunc func(t *Tower, enemies []*Enemy) *Enemy
+
+// Choose calls f(t, enemies).
+func (f TargetingFunc) Choose(t *Tower, enemies []*Enemy) *Enemy {
+	return f(t, enemies)
+}
+
+// targetings contains the strategies of all the aims.
+var targetings = map[Aim]Targeting{
+	First: maxBy(func(_ *Tower, a, b *Enemy) int {
+		return compareProgress(a, b)
+	}),
+	Last: maxBy(func(_ *Tower, a, b *Enemy) int {
+		return compareProgress(b, a)
+	}),
+	Weakest: maxBy(func(_ *Tower, a, b *Enemy) int {
+		return cmp.Compare(b.State.Health, a.State.Health)
+	}),
+	Strongest: maxBy(func(_ *Tower, a, b *Enemy) int {
+		return cmp.Compare(a.State.Health, b.State.Health)
+	}),
+	Closest: maxBy(func(t *Tower, a, b *Enemy) int {
+		return cmp.Compare(general.Distance2(t.State.Pos, b.State.Pos), general.Distance2(t.State.Pos, a.State.Pos))
+	}),
+	Fastest: maxBy(func(_ *Tower, a, b *Enemy) int {
+		return cmp.Compare(a.Vrms, b.Vrms)
+	}),
+	MostDamaged: maxBy(func(_ *Tower, a, b *Enemy) int {
+		// compares health fractions without division
+		return cmp.Compare(b.State.Health*a.MaxHealth, a.State.Health*b.MaxHealth)
+	}),
+	Armored: maxBy(func(t *Tower, a, b *Enemy) int {
+		return cmp.Compare(t.Damage-a.FinalDamage(t.Type, t.Damage), t.Damage-b.FinalDamage(t.Type, t.Damage))
+	}),
+	Richest: maxBy(func(_ *Tower, a, b *Enemy) int {
+		return cmp.Compare(a.MoneyAward, b.MoneyAward)
+	}),
+	Vulnerable: maxBy(func(t *Tower, a, b *Enemy) int {
+		return cmp.Compare(a.FinalDamage(t.Type, t.Damage), b.FinalDamage(t.Type, t.Damage))
+	}),
+}
+
+// Targeting returns the strategy of the aim.
+// Unknown aims fall back to First.
+func (a Aim) Targeting() Targeting {
+	if t, ok := targetings[a]; ok {
+		return t
+	}
+
+	return targetings[First]
+}
+
+// maxBy returns the strategy that chooses the maximal enemy by the comparator.
+func maxBy(compare func(t *Tower, a, b *Enemy) int) Targeting {
+	return TargetingFunc(func(t *Tower, enemies []*Enemy) *Enemy {
+		return slices.MaxFunc(enemies, func(a, b *Enemy) int {
+			return compare(t, a, b)
+		})
+	})
+}
+
+// compareProgress compares how far the enemies have gone along their paths.
+func compareProgress(a, b *Enemy) int {
+	if c := cmp.Compare(a.State.CurrPoint, b.State.CurrPoint); c != 0 {
+		return c
+	}
+
+	// the less distance to the next point left, the further the enemy is
+	return cmp.Compare(general.Coord(b.State.TimeNextPointLeft)*b.Vrms, general.Coord(a.State.TimeNextPointLeft)*a.Vrms)
+}
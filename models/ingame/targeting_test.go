@@ -0,0 +1,87 @@
+package ingame_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+// newTargets returns the tower and three enemies, each of them is chosen by some of the aims.
+func newTargets() (*ingame.Tower, map[string]*ingame.Enemy) {
+	tw := &ingame.Tower{
+		Type:  1,
+		Stats: ingame.Stats{Damage: 10},
+		State: ingame.TowerState{Pos: general.Point{}},
+	}
+
+	enemies := map[string]*ingame.Enemy{
+		// the furthest along the path, the least health, armored
+		"a": {
+			State:      ingame.EnemyState{CurrPoint: 2, TimeNextPointLeft: 10, Health: 30, Pos: general.Point{X: 300}},
+			MaxHealth:  100,
+			Vrms:       1,
+			MoneyAward: 1,
+			Strengths:  map[general.TypeAttack]ingame.Strength{1: {T: 1, DecDmg: 7}},
+		},
+		// the most health, the fastest, vulnerable
+		"b": {
+			State:      ingame.EnemyState{CurrPoint: 0, TimeNextPointLeft: 5, Health: 80, Pos: general.Point{X: 100}},
+			MaxHealth:  80,
+			Vrms:       3,
+			MoneyAward: 2,
+			Weaknesses: map[general.TypeAttack]ingame.Weakness{1: {T: 1, IncDmg: 5}},
+		},
+		// the last on the path, the closest, the most damaged, the richest
+		"c": {
+			State:      ingame.EnemyState{CurrPoint: 0, TimeNextPointLeft: 100, Health: 40, Pos: general.Point{X: 50}},
+			MaxHealth:  400,
+			Vrms:       2,
+			MoneyAward: 9,
+		},
+	}
+
+	return tw, enemies
+}
+
+func TestTargeting(t *testing.T) {
+	tests := []struct {
+		aim  ingame.Aim
+		want string
+	}{
+		{aim: ingame.First, want: "a"},
+		{aim: ingame.Last, want: "c"},
+		{aim: ingame.Weakest, want: "a"},
+		{aim: ingame.Strongest, want: "b"},
+		{aim: ingame.Closest, want: "c"},
+		{aim: ingame.Fastest, want: "b"},
+		{aim: ingame.MostDamaged, want: "c"},
+		{aim: ingame.Armored, want: "a"},
+		{aim: ingame.Richest, want: "c"},
+		{aim: ingame.Vulnerable, want: "b"},
+		// unknown aims fall back to First
+		{aim: ingame.Aim(-1), want: "a"},
+	}
+
+	tw, enemies := newTargets()
+	orders := [][]string{{"a", "b", "c"}, {"c", "b", "a"}, {"b", "c", "a"}}
+	for _, tt := range tests {
+		for _, order := range orders {
+			list := make([]*ingame.Enemy, 0, len(order))
+			for _, name := range order {
+				list = append(list, enemies[name])
+			}
+
+			got := tt.aim.Targeting().Choose(tw, list)
+			if got != enemies[tt.want] {
+				i := slices.Index(list, got)
+				t.Errorf("%v in order %v: chose %v, want %v", tt.aim, order, order[i], tt.want)
+			}
+		}
+	}
+
+	if len(ingame.Aims) != len(tests)-1 {
+		t.Errorf("%d aims are tested, there are %d", len(tests)-1, len(ingame.Aims))
+	}
+}
@@ -1,10 +1,8 @@
 package ingame
 
 import (
-	"cmp"
 	"image/color"
 	"math"
-	"slices"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
@@ -13,28 +11,24 @@ import (
 	"github.com/gopher-co/td-game/models/general"
 )
 
-// Aim is a type that represents the enemy that tower attacks.
-type Aim int
-
-const (
-	// First is a type of aim that represents the first enemy.
-	First = Aim(iota)
-
-	// Weakest is a type of aim that represents the weakest enemy.
-	Weakest
-
-	// Strongest is a type of aim that represents the strongest enemy.
-	Strongest
-)
-
 // Tower is a struct that represents a tower.
 type Tower struct {
+	// Index is an index of the tower on its map.
+	// It is set when the tower is built.
 	Index int
+
 	// Name is a name of the tower.
 	Name string
 
-	// Damage is a damage of the tower.
-	Damage int
+	// Kind is a kind of the tower.
+	Kind config.TowerKind
+
+	// Stats are the effective stats of the tower.
+	// They are computed from Base by UpdateStats.
+	Stats
+
+	// Base are the stats of the tower without upgrades and auras.
+	Base Stats
 
 	// Type is a type of the tower.
 	Type general.TypeAttack
@@ -42,45 +36,62 @@ type Tower struct {
 	// Price is a price of the tower.
 	Price int
 
+	// CritChance is a percent of the projectiles of the tower that deal the critical damage.
+	CritChance int
+
+	// CritDamage is a critical damage of the tower in percent of its damage.
+	CritDamage int
+
 	// Image is an image of the tower.
 	Image *ebiten.Image
 
-	// Radius is a radius of the tower.
-	Radius general.Coord
-
 	// State is a state of the tower.
 	State TowerState
 
-	// SpeedAttack is a speed of the tower's attack.
-	SpeedAttack general.Frames
-
-	// ProjectileVrms is a root mean square speed of the tower's projectile.
-	ProjectileVrms general.Coord
-
 	// ProjectileImage is an image of the tower's projectile.
 	ProjectileImage *ebiten.Image
 
-	// Upgrades is a list of upgrades of the tower.
+	// Aura is an effect of the aura tower on the towers in its radius.
+	Aura *Aura
+
+	// Ability is an active ability of the tower.
+	Ability *Ability
+
+	// Upgrades is a list of all the upgrades of the tower.
+	// They form a tree by their Parent fields.
 	Upgrades []*Upgrade
 
-	// UpgradesBought is a number of upgrades bought.
-	UpgradesBought int
+	// Bought is a list of the upgrades bought in the order of buying.
+	// Each upgrade is a child of the previous one.
+	Bought []*Upgrade
+
+	// MaxUpgrades is a maximal number of the upgrades that can be bought.
+	// If it's zero, the upgrades aren't limited.
+	MaxUpgrades int
+
+	// Ranks is a list of the veterancy ranks of the tower in the order of gaining.
+	Ranks []*Rank
+
+	// Kills is a number of the enemies killed by the tower.
+	Kills int
+
+	// DamageDealt is a damage dealt by the tower's projectiles.
+	DamageDealt int
 
 	// Chosen is a flag that shows if the tower is chosen.
 	Chosen bool
 
 	// Sold is a flag that shows if the tower is sold.
 	Sold bool
-}
 
-var globalIndex int
+	// auras are the auras affecting the tower now.
+	auras []Modifier
+}
 
 // NewTower creates a new entity of Tower.
-func NewTower(config *config.Tower, pos general.Point, path Path) *Tower {
-	if CheckCollisionPath(pos, path) {
-		return nil
-	}
-
+//
+// The placement rules are not checked, use CanPlaceTower before.
+func NewTower(config *config.Tower, pos general.Point) *Tower {
 	initState := TowerState{
 		AimType:    First,
 		IsTurnedOn: true,
@@ -89,29 +100,71 @@ func NewTower(config *config.Tower, pos general.Point, path Path) *Tower {
 		Aim:        nil,
 	}
 
+	base := Stats{
+		Damage:         config.InitDamage,
+		Radius:         config.InitRadius,
+		SpeedAttack:    config.InitSpeedAttack,
+		ProjectileVrms: config.InitProjectileVrms,
+		Income:         config.Income,
+	}
+
 	t := &Tower{
-		Index:           globalIndex,
 		Name:            config.Name,
-		Damage:          config.InitDamage,
+		Kind:            config.TowerKind(),
+		Stats:           base,
+		Base:            base,
 		Type:            config.Type,
 		Price:           config.Price,
+		CritChance:      config.CritChance,
+		CritDamage:      config.CritDamage,
 		Image:           config.Image(),
-		Radius:          config.InitRadius,
 		State:           initState,
-		SpeedAttack:     config.InitSpeedAttack,
-		ProjectileVrms:  config.InitProjectileVrms,
 		ProjectileImage: config.ProjectileConfig.Image(),
-		UpgradesBought:  0,
+		Aura:            NewAura(config.Aura),
+		Ability:         NewAbility(config.Ability),
+		Ranks:           NewRanks(config.Ranks),
 	}
-	globalIndex++
 
-	t.initUpgrades(config.Upgrades)
+	t.initUpgrades(config.UpgradeTree())
 
 	return t
 }
 
+// UpdateStats computes the effective stats of the tower
+// from the base stats, the bought upgrades, the rank, the auras and the overdrive.
+func (t *Tower) UpdateStats() {
+	s := t.upgradedStats()
+	for _, m := range t.auras {
+		s = m.Modify(s)
+	}
+	if t.Ability != nil {
+		s = t.Ability.Modify(s)
+	}
+
+	// keeps the stats meaningful whatever the modifiers are
+	s.Damage = max(s.Damage, 0)
+	s.Radius = max(s.Radius, 0)
+	s.SpeedAttack = max(s.SpeedAttack, 1)
+
+	t.Stats = s
+}
+
+// upgradedStats returns the stats of the tower with the upgrades and the rank but without the auras.
+func (t *Tower) upgradedStats() Stats {
+	s := t.Base
+	for _, u := range t.Bought {
+		s = u.Modify(s)
+	}
+	if r := t.Rank(); r >= 0 {
+		s = t.Ranks[r].Modify(s)
+	}
+
+	return s
+}
+
 // Launch launches a projectile from the tower.
-func (t *Tower) Launch() *Projectile {
+// The critical hit and the evasion of the target are rolled by r at the launch.
+func (t *Tower) Launch(r *Random) *Projectile {
 	if t.Sold || t.State.CoolDown != 0 || t.State.Aim == nil {
 		return nil
 	}
@@ -126,49 +179,149 @@ func (t *Tower) Launch() *Projectile {
 		Damage:      t.Damage,
 		TTL:         0,
 		TargetEnemy: t.State.Aim,
+		Source:      t,
 		Image:       t.ProjectileImage,
+		prevPos:     t.State.Pos,
 	}
 	target := p.TargetEnemy.State.Pos
-	z := math.Hypot(float64(target.X-p.Pos.X), float64(target.Y-p.Pos.Y))
+	z := general.Hypot(float64(target.X-p.Pos.X), float64(target.Y-p.Pos.Y))
 
 	ttl := math.Round(z / float64(p.Vrms))
 	p.TTL = int(ttl)
 	p.Vx = general.Coord(float64(target.X-p.Pos.X) / ttl)
 	p.Vy = general.Coord(float64(target.Y-p.Pos.Y) / ttl)
 
+	if r.Chance(t.CritChance) {
+		p.Damage = p.Damage * t.CritDamage / 100
+		p.Critical = true
+	}
+	p.evaded = r.Chance(p.TargetEnemy.Evasion)
+
 	return p
 }
 
-// Upgrade upgrades the tower.
-func (t *Tower) Upgrade(complete map[string]struct{}) bool {
-
-	if t.UpgradesBought == len(t.Upgrades) {
-		return false
+// Upgrade buys the upgrade with the id and applies it to the tower.
+// If the id is empty, the first available upgrade is bought.
+//
+// Returns the bought upgrade or nil if the upgrade isn't available
+// or its level isn't complete. If complete is nil, levels are not checked.
+func (t *Tower) Upgrade(id string, complete map[string]Difficulties) *Upgrade {
+	upg := t.NextUpgrade(id)
+	if upg == nil {
+		return nil
 	}
 
-	upg := t.Upgrades[t.UpgradesBought]
 	if complete != nil && upg.OpenLevel != "" {
 		if _, ok := complete[upg.OpenLevel]; !ok {
-			return false
+			return nil
 		}
 	}
 
-	t.SpeedAttack += upg.DeltaSpeedAttack
-	t.Damage += upg.DeltaDamage
-	t.Radius += upg.DeltaRadius
+	if upg.Type != nil {
+		t.Type = *upg.Type
+	}
+	if upg.ProjectileImage != nil {
+		t.ProjectileImage = upg.ProjectileImage
+	}
+
+	t.Bought = append(t.Bought, upg)
+	t.UpdateStats()
+
+	return upg
+}
 
-	t.UpgradesBought++
+// NextUpgrade returns the available upgrade with the id.
+// If the id is empty, returns the first available upgrade.
+// Returns nil if there is no such upgrade.
+func (t *Tower) NextUpgrade(id string) *Upgrade {
+	for _, u := range t.AvailableUpgrades() {
+		if id == "" || u.ID == id {
+			return u
+		}
+	}
 
-	return true
+	return nil
 }
 
-// NextUpgrade returns the next upgrade.
-func (t *Tower) NextUpgrade() *Upgrade {
-	if len(t.Upgrades) == t.UpgradesBought {
+// AvailableUpgrades returns the upgrades that can be bought next,
+// i.e. the children of the last bought upgrade.
+// Returns nil if MaxUpgrades upgrades are bought.
+func (t *Tower) AvailableUpgrades() []*Upgrade {
+	if t.MaxUpgrades > 0 && len(t.Bought) >= t.MaxUpgrades {
 		return nil
 	}
 
-	return t.Upgrades[t.UpgradesBought]
+	return t.children(t.lastBought())
+}
+
+// UpgradeTree returns the nodes of the upgrade tree in the depth-first order.
+func (t *Tower) UpgradeTree() []UpgradeNode {
+	bought := make(map[*Upgrade]struct{}, len(t.Bought))
+	for _, u := range t.Bought {
+		bought[u] = struct{}{}
+	}
+	last := t.lastBought()
+
+	var nodes []UpgradeNode
+	var walk func(parent string, depth int, closed bool)
+	walk = func(parent string, depth int, closed bool) {
+		for _, u := range t.children(parent) {
+			status := UpgradeLater
+			if _, ok := bought[u]; ok {
+				status = UpgradeBought
+			} else if closed || t.MaxUpgrades > 0 && depth >= t.MaxUpgrades {
+				status = UpgradeClosed
+			} else if u.Parent == last {
+				status = UpgradeAvailable
+			} else if len(t.Bought) > depth {
+				// a sibling of this upgrade has been bought
+				status = UpgradeClosed
+			}
+
+			nodes = append(nodes, UpgradeNode{Upgrade: u, Depth: depth, Status: status})
+			walk(u.ID, depth+1, status == UpgradeClosed)
+		}
+	}
+	walk("", 0, false)
+
+	return nodes
+}
+
+// SpentOnUpgrades returns the total price of the bought upgrades.
+func (t *Tower) SpentOnUpgrades() int {
+	p := 0
+	for _, u := range t.Bought {
+		p += u.Price
+	}
+
+	return p
+}
+
+// lastBought returns the ID of the last bought upgrade or an empty string.
+func (t *Tower) lastBought() string {
+	if len(t.Bought) == 0 {
+		return ""
+	}
+
+	return t.Bought[len(t.Bought)-1].ID
+}
+
+// children returns the upgrades which parent has the id.
+func (t *Tower) children(id string) []*Upgrade {
+	var ups []*Upgrade
+	for _, u := range t.Upgrades {
+		if u.Parent == id {
+			ups = append(ups, u)
+		}
+	}
+
+	return ups
+}
+
+// UseAbility activates the ability of the tower.
+// Returns false if the tower has no ability or it is on cooldown.
+func (t *Tower) UseAbility() bool {
+	return !t.Sold && t.Ability != nil && t.Ability.Activate(t.State.Pos)
 }
 
 // Update updates the tower.
@@ -191,88 +344,30 @@ func (t *Tower) Draw(screen *ebiten.Image) {
 	geom := ebiten.GeoM{}
 	geom.Translate(float64(t.State.Pos.X-float32(t.Image.Bounds().Dx()/2)), float64(t.State.Pos.Y-float32(t.Image.Bounds().Dy()/2)))
 	screen.DrawImage(t.Image, &ebiten.DrawImageOptions{GeoM: geom})
+	t.drawBadge(screen)
 }
 
-// TakeAim takes aim at the enemy.
+// TakeAim takes aim at the enemy chosen by the tower's targeting strategy.
 func (t *Tower) TakeAim(e1 []*Enemy) {
-	switch t.State.AimType {
-	case First:
-		t.takeAimFirst(e1)
-	case Strongest:
-		t.takeAimStrong(e1)
-	case Weakest:
-		t.takeAimWeak(e1)
-	}
-}
-
-// takeAimFirst takes aim at the first enemy.
-func (t *Tower) takeAimFirst(e1 []*Enemy) {
-	enemies := slices.Clone(e1)
-	enemies = slices.DeleteFunc(enemies, func(e *Enemy) bool {
-		tx, ty, ex, ey := t.State.Pos.X, t.State.Pos.Y, e.State.Pos.X, e.State.Pos.Y
-		return e.State.Dead || (tx-ex)*(tx-ex)+(ty-ey)*(ty-ey) > t.Radius*t.Radius
-	})
-
-	if len(enemies) == 0 {
-		t.State.Aim = nil
-		return
-	}
-
-	e := slices.MaxFunc(enemies, func(a, b *Enemy) int {
-		if a.State.CurrPoint > b.State.CurrPoint {
-			return 1
-		} else if a.State.CurrPoint < b.State.CurrPoint {
-			return -1
-		}
-		if general.Coord(a.State.TimeNextPointLeft)*a.Vrms < general.Coord(b.State.TimeNextPointLeft)*b.Vrms {
-			return 1
-		} else if general.Coord(a.State.TimeNextPointLeft)*a.Vrms > general.Coord(b.State.TimeNextPointLeft)*b.Vrms {
-			return -1
-		}
-		return 0
-	})
-
-	t.State.Aim = e
-}
-
-// takeAimWeak takes aim at the weak enemy.
-func (t *Tower) takeAimWeak(e1 []*Enemy) {
-	enemies := slices.Clone(e1)
-	enemies = slices.DeleteFunc(enemies, func(e *Enemy) bool {
-		tx, ty, ex, ey := t.State.Pos.X, t.State.Pos.Y, e.State.Pos.X, e.State.Pos.Y
-		return e.State.Dead || (tx-ex)*(tx-ex)+(ty-ey)*(ty-ey) > t.Radius*t.Radius
-	})
-
+	enemies := t.enemiesInRange(e1)
 	if len(enemies) == 0 {
 		t.State.Aim = nil
 		return
 	}
 
-	e := slices.MaxFunc(enemies, func(a, b *Enemy) int {
-		return cmp.Compare(b.State.Health, a.State.Health)
-	})
-
-	t.State.Aim = e
+	t.State.Aim = t.State.AimType.Targeting().Choose(t, enemies)
 }
 
-// takeAimStrong takes aim at the strong enemy.
-func (t *Tower) takeAimStrong(e1 []*Enemy) {
-	enemies := slices.Clone(e1)
-	enemies = slices.DeleteFunc(enemies, func(e *Enemy) bool {
-		tx, ty, ex, ey := t.State.Pos.X, t.State.Pos.Y, e.State.Pos.X, e.State.Pos.Y
-		return e.State.Dead || (tx-ex)*(tx-ex)+(ty-ey)*(ty-ey) > t.Radius*t.Radius
-	})
-
-	if len(enemies) == 0 {
-		t.State.Aim = nil
-		return
+// enemiesInRange returns the alive visible enemies that are in the tower's range.
+func (t *Tower) enemiesInRange(e1 []*Enemy) []*Enemy {
+	enemies := make([]*Enemy, 0, len(e1))
+	for _, e := range e1 {
+		if !e.State.Dead && e.Visible() && general.Distance2(t.State.Pos, e.State.Pos) <= t.Radius*t.Radius {
+			enemies = append(enemies, e)
+		}
 	}
 
-	e := slices.MaxFunc(enemies, func(a, b *Enemy) int {
-		return cmp.Compare(a.State.Health, b.State.Health)
-	})
-
-	t.State.Aim = e
+	return enemies
 }
 
 // CheckCollisionPath checks if the path collides with the tower.
@@ -286,6 +381,17 @@ func CheckCollisionPath(pos general.Point, path Path) bool {
 	return false
 }
 
+// CheckCollisionPaths checks if any of the paths collides with the tower.
+func CheckCollisionPaths(pos general.Point, paths Paths) bool {
+	for _, p := range paths {
+		if CheckCollisionPath(pos, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // checkCollision checks if the point collides with the line segment.
 func checkCollision(p, p1, p2 general.Point) bool {
 	x1, x2 := float64(p1.X), float64(p2.X)
@@ -304,13 +410,14 @@ func checkCollision(p, p1, p2 general.Point) bool {
 	// 		*	         a*****
 	// 		()****************(x2,y2)
 	//					x
-	z := math.Hypot(x2-x1, y2-y1)
+	z := general.Hypot(x2-x1, y2-y1)
 
+	// the products are rounded before the sums to be the same on every platform, see general/math.go
 	sina := (y2 - y1) / z
-	cosa := sign(x2-x1) * math.Sqrt(1-math.Pow(sina, 2))
+	cosa := sign(x2-x1) * math.Sqrt(1-float64(sina*sina))
 
-	dx := config.PathWidth / 2 * cosa
-	dy := config.PathWidth / 2 * sina
+	dx := float64(config.PathWidth / 2 * cosa)
+	dy := float64(config.PathWidth / 2 * sina)
 
 	x1 -= dx
 	x2 += dx
@@ -322,7 +429,7 @@ func checkCollision(p, p1, p2 general.Point) bool {
 	D := general.Point{X: general.Coord(x2 + dy), Y: general.Coord(y2 - dx)}
 
 	sc := func(p1, p2 general.Point) general.Coord {
-		return p1.X*p2.X + p1.Y*p2.Y
+		return general.Coord(p1.X*p2.X) + general.Coord(p1.Y*p2.Y)
 	}
 
 	AM := general.Point{X: p.X - A.X, Y: p.Y - A.Y}
@@ -1,12 +1,23 @@
 package ingame
 
 import (
+	"github.com/hajimehoshi/ebiten/v2"
+
 	"github.com/gopher-co/td-game/models/config"
 	"github.com/gopher-co/td-game/models/general"
 )
 
 // Upgrade is an entity stores useful effects for towers.
 type Upgrade struct {
+	// ID is an identifier of the upgrade unique within the tower.
+	ID string
+
+	// Parent is an ID of the upgrade that must be bought before this one.
+	Parent string
+
+	// Name is a name of the upgrade.
+	Name string
+
 	// Price is a price of the upgrade.
 	Price int
 
@@ -19,17 +30,74 @@ type Upgrade struct {
 	// DeltaRadius is a delta of the radius.
 	DeltaRadius general.Coord
 
+	// DeltaProjectileVrms is a delta of the projectile's speed.
+	DeltaProjectileVrms general.Coord
+
+	// DeltaIncome is a delta of the income.
+	DeltaIncome int
+
+	// Type replaces the type of the tower's attack if it is not nil.
+	Type *general.TypeAttack
+
+	// ProjectileImage replaces the image of the tower's projectile if it is not nil.
+	ProjectileImage *ebiten.Image
+
 	// OpenLevel is a level when the upgrade is opened.
 	OpenLevel string
 }
 
 // NewUpgrade returns a new upgrade.
 func NewUpgrade(config *config.Upgrade) *Upgrade {
-	return &Upgrade{
-		Price:            config.Price,
-		DeltaDamage:      config.DeltaDamage,
-		DeltaSpeedAttack: config.DeltaSpeedAttack,
-		DeltaRadius:      config.DeltaRadius,
-		OpenLevel:        config.OpenLevel,
+	u := &Upgrade{
+		ID:                  config.ID,
+		Parent:              config.Parent,
+		Name:                config.Name,
+		Price:               config.Price,
+		DeltaDamage:         config.DeltaDamage,
+		DeltaSpeedAttack:    config.DeltaSpeedAttack,
+		DeltaRadius:         config.DeltaRadius,
+		DeltaProjectileVrms: config.DeltaProjectileVrms,
+		DeltaIncome:         config.DeltaIncome,
+		Type:                config.Type,
+		OpenLevel:           config.OpenLevel,
+	}
+
+	if config.ProjectileConfig != nil {
+		u.ProjectileImage = config.ProjectileConfig.Image()
+	}
+
+	if u.Name == "" {
+		u.Name = "Upgrade " + u.ID
 	}
+
+	return u
+}
+
+// UpgradeStatus is a status of the upgrade of the tower.
+type UpgradeStatus int
+
+const (
+	// UpgradeBought is a status of the bought upgrade.
+	UpgradeBought = UpgradeStatus(iota)
+
+	// UpgradeAvailable is a status of the upgrade that can be bought next.
+	UpgradeAvailable
+
+	// UpgradeLater is a status of the upgrade that can be bought after its parent.
+	UpgradeLater
+
+	// UpgradeClosed is a status of the upgrade that is on the branch not chosen.
+	UpgradeClosed
+)
+
+// UpgradeNode is a node of the tower's upgrade tree.
+type UpgradeNode struct {
+	// Upgrade is the upgrade of the node.
+	Upgrade *Upgrade
+
+	// Depth is a depth of the node in the tree, roots have depth 0.
+	Depth int
+
+	// Status is a status of the upgrade.
+	Status UpgradeStatus
 }
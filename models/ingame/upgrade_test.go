@@ -0,0 +1,93 @@
+package ingame_test
+
+import (
+	"testing"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
+)
+
+func TestUpgradeTree(t *testing.T) {
+	cfg := &config.Tower{Upgrades: []config.Upgrade{
+		{ID: "a", DeltaDamage: 1},
+		{ID: "range", Parent: "a", DeltaRadius: 10},
+		{ID: "damage", Parent: "a", DeltaDamage: 5},
+		{ID: "big", Parent: "damage", DeltaDamage: 10},
+	}}
+	if err := cfg.Valid(); err != nil {
+		t.Fatal(err)
+	}
+
+	tw := &ingame.Tower{}
+	for _, u := range cfg.UpgradeTree() {
+		tw.Upgrades = append(tw.Upgrades, ingame.NewUpgrade(&u))
+	}
+
+	if tw.Upgrade("damage", nil) != nil {
+		t.Fatal("bought the upgrade before its parent")
+	}
+	if tw.Upgrade("a", nil) == nil || tw.Upgrade("damage", nil) == nil {
+		t.Fatal("can't buy the available upgrade")
+	}
+	if tw.Upgrade("range", nil) != nil {
+		t.Fatal("bought the upgrade of the closed branch")
+	}
+	if tw.Damage != 6 {
+		t.Errorf("damage = %d, want 6", tw.Damage)
+	}
+
+	want := map[string]ingame.UpgradeStatus{
+		"a":      ingame.UpgradeBought,
+		"range":  ingame.UpgradeClosed,
+		"damage": ingame.UpgradeBought,
+		"big":    ingame.UpgradeAvailable,
+	}
+	for _, n := range tw.UpgradeTree() {
+		if n.Status != want[n.Upgrade.ID] {
+			t.Errorf("status of %s = %d, want %d", n.Upgrade.ID, n.Status, want[n.Upgrade.ID])
+		}
+	}
+}
+
+func TestLinearUpgrades(t *testing.T) {
+	typ := general.TypeAttack(2)
+	cfg := &config.Tower{Upgrades: []config.Upgrade{{Price: 1}, {Price: 2, Type: &typ}}}
+
+	tw := &ingame.Tower{}
+	for _, u := range cfg.UpgradeTree() {
+		tw.Upgrades = append(tw.Upgrades, ingame.NewUpgrade(&u))
+	}
+
+	if u := tw.Upgrade("", nil); u == nil || u.Price != 1 {
+		t.Fatalf("first upgrade = %v, want the price 1", u)
+	}
+	if u := tw.Upgrade("", nil); u == nil || u.Price != 2 {
+		t.Fatalf("second upgrade = %v, want the price 2", u)
+	}
+	if tw.Type != typ {
+		t.Errorf("type = %d, want %d", tw.Type, typ)
+	}
+	if tw.Upgrade("", nil) != nil {
+		t.Error("bought more upgrades than there are")
+	}
+}
+
+func TestMaxUpgrades(t *testing.T) {
+	cfg := &config.Tower{Upgrades: []config.Upgrade{{Price: 1}, {Price: 2}}}
+
+	tw := &ingame.Tower{MaxUpgrades: 1}
+	for _, u := range cfg.UpgradeTree() {
+		tw.Upgrades = append(tw.Upgrades, ingame.NewUpgrade(&u))
+	}
+
+	if tw.Upgrade("", nil) == nil {
+		t.Fatal("the first upgrade isn't bought")
+	}
+	if tw.Upgrade("", nil) != nil {
+		t.Error("bought more upgrades than the limit")
+	}
+	if st := tw.UpgradeTree()[1].Status; st != ingame.UpgradeClosed {
+		t.Errorf("status of the upgrade over the limit = %d, want %d", st, ingame.UpgradeClosed)
+	}
+}
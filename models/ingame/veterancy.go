@@ -0,0 +1,92 @@
+package ingame
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/general"
+)
+
+// Rank is a veterancy rank of the tower.
+type Rank struct {
+	// Name is a name of the rank.
+	Name string
+
+	// Kills is a number of the kills required for the rank.
+	Kills int
+
+	// Damage is a damage dealt required for the rank.
+	Damage int
+
+	// DeltaDamage is a delta of the damage.
+	DeltaDamage int
+
+	// DeltaRadius is a delta of the radius.
+	DeltaRadius general.Coord
+
+	// DeltaSpeedAttack is a delta of the speed of the attack.
+	DeltaSpeedAttack general.Frames
+}
+
+// NewRanks creates the ranks from the configs.
+func NewRanks(configs []config.Rank) []*Rank {
+	rs := make([]*Rank, len(configs))
+	for i, c := range configs {
+		rs[i] = &Rank{
+			Name:             c.Name,
+			Kills:            c.Kills,
+			Damage:           c.Damage,
+			DeltaDamage:      c.DeltaDamage,
+			DeltaRadius:      c.DeltaRadius,
+			DeltaSpeedAttack: c.DeltaSpeedAttack,
+		}
+	}
+
+	return rs
+}
+
+// Modify adds the deltas of the rank to the stats.
+func (r *Rank) Modify(s Stats) Stats {
+	s.Damage += r.DeltaDamage
+	s.Radius += r.DeltaRadius
+	s.SpeedAttack += r.DeltaSpeedAttack
+
+	return s
+}
+
+// Rank returns the index of the highest rank gained by the tower or -1 if it has none.
+func (t *Tower) Rank() int {
+	rank := -1
+	for i, r := range t.Ranks {
+		if t.Kills < r.Kills || t.DamageDealt < r.Damage {
+			break
+		}
+		rank = i
+	}
+
+	return rank
+}
+
+// gainExperience adds the damage dealt by the tower and the kill if the enemy was killed.
+func (t *Tower) gainExperience(dmg int, kill bool) {
+	t.DamageDealt += dmg
+	if kill {
+		t.Kills++
+	}
+}
+
+// badgeColor is a color of the rank badge.
+var badgeColor = color.RGBA{R: 0xff, G: 0xd7, A: 0xff}
+
+// drawBadge draws a stripe for each gained rank in the top left corner of the tower.
+func (t *Tower) drawBadge(screen *ebiten.Image) {
+	x := t.State.Pos.X - config.TowerImageWidth/2 + 4
+	y := t.State.Pos.Y - config.TowerImageWidth/2 + 4
+
+	for i := range t.Rank() + 1 {
+		vector.DrawFilledRect(screen, x, y+float32(i)*8, 20, 5, badgeColor, false)
+	}
+}
@@ -1,6 +1,8 @@
 package ingame
 
 import (
+	"math"
+
 	"github.com/gopher-co/td-game/models/config"
 	"github.com/gopher-co/td-game/models/general"
 )
@@ -8,6 +10,22 @@ import (
 // GameRule is a set of waves.
 type GameRule []*Wave
 
+// Clone returns a copy of the game rule, the progress of its swarms isn't shared with it.
+func (gr GameRule) Clone() GameRule {
+	c := make(GameRule, len(gr))
+	for i, w := range gr {
+		cw := *w
+		cw.Swarms = make([]*EnemySwarm, len(w.Swarms))
+		for j, sw := range w.Swarms {
+			csw := *sw
+			cw.Swarms[j] = &csw
+		}
+		c[i] = &cw
+	}
+
+	return c
+}
+
 // NewGameRule returns a new GameRule.
 func NewGameRule(config []config.Wave) GameRule {
 	grs := make(GameRule, len(config))
@@ -37,22 +55,22 @@ func NewWave(config *config.Wave) *Wave {
 	return &Wave{Swarms: swarms}
 }
 
-// CallEnemies returns a slice of ids of enemies that are
+// CallEnemies returns a slice of swarms whose enemies are
 // supposed to appear on the map next frame.
-func (w *Wave) CallEnemies() []string {
-	es := make([]string, 0, len(w.Swarms))
+func (w *Wave) CallEnemies() []*EnemySwarm {
+	es := make([]*EnemySwarm, 0, len(w.Swarms))
 	for _, v := range w.Swarms {
 		if v.Ended() {
 			continue
 		}
 		if e := v.Update(w.Time); e != "" {
-			es = append(es, e)
+			es = append(es, v)
 		}
 	}
 
 	w.Time++
 
-	es1 := make([]string, len(es))
+	es1 := make([]*EnemySwarm, len(es))
 	copy(es1, es)
 
 	return es1
@@ -89,17 +107,42 @@ type EnemySwarm struct {
 
 	// CurCalls is the current amount of enemies called.
 	CurCalls int
+
+	// PathName is a name of the path the enemies go along.
+	PathName string
+
+	// HealthMultiplier multiplies the maximal health of the enemies.
+	HealthMultiplier float64
 }
 
 // NewEnemySwarm returns a new EnemySwarm.
 func NewEnemySwarm(config *config.EnemySwarm) *EnemySwarm {
 	return &EnemySwarm{
-		EnemyName: config.EnemyName,
-		Timeout:   config.Timeout,
-		Interval:  config.Interval,
-		MaxCalls:  config.MaxCalls,
-		CurCalls:  0,
+		EnemyName:        config.EnemyName,
+		Timeout:          config.Timeout,
+		Interval:         config.Interval,
+		MaxCalls:         config.MaxCalls,
+		CurCalls:         0,
+		PathName:         config.PathName,
+		HealthMultiplier: config.HealthMultiplier,
+	}
+}
+
+// NewEnemy creates a new enemy of the swarm from the config
+// on the path with the name PathName.
+// The health of the enemy is multiplied by the swarm's HealthMultiplier and by multiplier.
+func (s *EnemySwarm) NewEnemy(cfg *config.Enemy, paths Paths, multiplier float64) *Enemy {
+	if s.HealthMultiplier > 0 {
+		multiplier *= s.HealthMultiplier
 	}
+
+	e := NewEnemy(cfg, paths.Get(s.PathName))
+	if multiplier != 1 {
+		e.MaxHealth = max(1, int(math.Round(float64(e.MaxHealth)*multiplier)))
+		e.State.Health = e.MaxHealth
+	}
+
+	return e
 }
 
 // Ended returns true if maximum calls amount exceeded.
@@ -0,0 +1,83 @@
+package menustate
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"golang.org/x/image/colornames"
+
+	"github.com/gopher-co/td-game/models/config"
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/ui"
+	"github.com/gopher-co/td-game/ui/font"
+)
+
+// loadAchievementsMenuUI loads the achievements menu UI.
+func (m *MenuState) loadAchievementsMenuUI(widgets general.Widgets) *ebitenui.UI {
+	bgImg := widgets[ui.MenuBackgroundImage]
+	menuBackground := image.NewNineSliceSimple(bgImg, 0, 1)
+
+	backBtn := widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{Idle: image.NewNineSliceSimple(widgets[ui.LevelMenuBackButtonImage], 0, 1)}),
+		widget.ButtonOpts.Text("<", font.TTF128, &widget.ButtonTextColor{Idle: color.White}),
+		widget.ButtonOpts.ClickedHandler(func(args *widget.ButtonClickedEventArgs) {
+			m.UI = m.loadMainMenuUI(widgets)
+		}),
+	)
+
+	root := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(1),
+			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, true}),
+			widget.GridLayoutOpts.Spacing(0, 0),
+		)),
+		widget.ContainerOpts.BackgroundImage(menuBackground),
+	)
+
+	root.AddChild(backBtn)
+	root.AddChild(m.loadAchievementsTable())
+
+	return &ebitenui.UI{Container: root}
+}
+
+// loadAchievementsTable loads the table of the achievements with their unlock state.
+func (m *MenuState) loadAchievementsTable() *widget.Container {
+	table := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(3),
+			widget.GridLayoutOpts.Stretch([]bool{false, true, false}, nil),
+			widget.GridLayoutOpts.Spacing(40, 20),
+			widget.GridLayoutOpts.Padding(widget.Insets{Top: 30, Left: 50, Right: 50}),
+		)),
+		widget.ContainerOpts.BackgroundImage(image.NewNineSliceColor(color.NRGBA{R: 0x13, G: 0x1a, B: 0x22, A: 0xff})),
+	)
+
+	for _, a := range m.Achievements {
+		clr := color.Color(colornames.Gray)
+		if m.AchievementProgress.IsUnlocked(a.Name) {
+			clr = colornames.Gold
+		}
+
+		table.AddChild(widget.NewText(widget.TextOpts.Text(a.Name, font.TTF36, clr)))
+		table.AddChild(widget.NewText(widget.TextOpts.Text(a.Description, font.TTF36, color.White)))
+		table.AddChild(widget.NewText(widget.TextOpts.Text(m.achievementStatus(a), font.TTF36, clr)))
+	}
+
+	return table
+}
+
+// achievementStatus returns the unlock state of the achievement
+// or the progress of the kills achievement.
+func (m *MenuState) achievementStatus(a *config.Achievement) string {
+	switch {
+	case m.AchievementProgress.IsUnlocked(a.Name):
+		return "Unlocked"
+	case a.Kind == config.AchievementKills:
+		return fmt.Sprintf("%d/%d", min(m.AchievementProgress.Kills, a.Count), a.Count)
+	default:
+		return "Locked"
+	}
+}
@@ -214,7 +214,13 @@ func (m *MenuState) loadCoopMenuUI(widgets general.Widgets) *ebitenui.UI {
 			if err != nil {
 				panic(err)
 			}
-			s, id := coopstate.NewServer(sLevel, mustAtoi(sCount))
+			s, id, err := coopstate.NewServer(m.GameContext, sLevel, mustAtoi(sCount))
+			if err != nil {
+				_ = l.Close()
+				connStringCopyText.Label = "Couldn't create the game:("
+				log.Println(err)
+				return
+			}
 			go func() { _ = s.Serve(l) }()
 			conn, err := grpc.Dial("localhost:24555", grpc.WithTransportCredentials(insecure.NewCredentials()))
 			if err != nil {
@@ -244,9 +250,10 @@ func (m *MenuState) loadCoopMenuUI(widgets general.Widgets) *ebitenui.UI {
 					return
 				}
 				m.Host = c
-				m.Ended = true
+				m.Seed = resp.Seed
 				m.Next = resp.Level
 				m.Stream = stream
+				m.Ended = true
 			}()
 		}),
 		widget.ButtonOpts.Image(&widget.ButtonImage{Idle: image.NewNineSliceColor(color.Black)}),
@@ -333,6 +340,7 @@ func (m *MenuState) loadCoopMenuUI(widgets general.Widgets) *ebitenui.UI {
 
 				m.Host = c
 				m.Next = resp.Level
+				m.Seed = resp.Seed
 				m.Stream = stream
 				m.Ended = true
 			}()
@@ -0,0 +1,110 @@
+package menustate
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"golang.org/x/image/colornames"
+
+	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/ui"
+	"github.com/gopher-co/td-game/ui/font"
+)
+
+// loadLeaderboardUI loads the leaderboard UI of the level on the chosen difficulty.
+func (m *MenuState) loadLeaderboardUI(widgets general.Widgets, level string) *ebitenui.UI {
+	bgImg := widgets[ui.MenuBackgroundImage]
+	menuBackground := image.NewNineSliceSimple(bgImg, 0, 1)
+
+	root := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(1),
+			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, true}),
+			widget.GridLayoutOpts.Spacing(0, 0),
+		)),
+		widget.ContainerOpts.BackgroundImage(menuBackground),
+	)
+
+	infoContainer := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(2),
+			widget.GridLayoutOpts.Stretch([]bool{false, true}, []bool{true}),
+		)),
+	)
+
+	backBtn := widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{Idle: image.NewNineSliceSimple(widgets[ui.LevelMenuBackButtonImage], 0, 1)}),
+		widget.ButtonOpts.Text("<", font.TTF128, &widget.ButtonTextColor{Idle: color.White}),
+		widget.ButtonOpts.ClickedHandler(func(args *widget.ButtonClickedEventArgs) {
+			m.UI = m.loadLevelMenuUI(widgets)
+		}),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:  35,
+			Right: 35,
+		}),
+	)
+
+	title := widget.NewText(
+		widget.TextOpts.Text(fmt.Sprintf("%s (%s)", level, m.Difficulty), font.TTF64, color.White),
+		widget.TextOpts.Position(widget.TextPositionCenter, widget.TextPositionCenter),
+	)
+
+	infoContainer.AddChild(backBtn)
+	infoContainer.AddChild(title)
+
+	root.AddChild(infoContainer)
+	root.AddChild(m.loadLeaderboardTable(level))
+
+	return &ebitenui.UI{Container: root}
+}
+
+// loadLeaderboardTable loads the table of the records of the level on the chosen difficulty.
+func (m *MenuState) loadLeaderboardTable(level string) *widget.Container {
+	table := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(5),
+			widget.GridLayoutOpts.Stretch([]bool{false, true, false, true, false}, nil),
+			widget.GridLayoutOpts.Spacing(40, 15),
+			widget.GridLayoutOpts.Padding(widget.Insets{Top: 30, Left: 50, Right: 50}),
+		)),
+		widget.ContainerOpts.BackgroundImage(image.NewNineSliceColor(color.NRGBA{R: 0x13, G: 0x1a, B: 0x22, A: 0xff})),
+	)
+
+	records := m.Leaderboard.Table(level, m.Difficulty)
+	if len(records) == 0 {
+		table.AddChild(widget.NewText(
+			widget.TextOpts.Text("No records yet", font.TTF36, color.White),
+		))
+		return table
+	}
+
+	for i, r := range records {
+		cells := []string{fmt.Sprintf("%d.", i+1), r.Nickname, fmt.Sprint(r.Score), r.Date}
+		for _, c := range cells {
+			table.AddChild(widget.NewText(
+				widget.TextOpts.Text(c, font.TTF36, color.White),
+				widget.TextOpts.Position(widget.TextPositionStart, widget.TextPositionCenter),
+			))
+		}
+
+		if r.Replay == "" {
+			table.AddChild(widget.NewContainer())
+			continue
+		}
+
+		table.AddChild(widget.NewButton(
+			widget.ButtonOpts.Image(&widget.ButtonImage{Idle: image.NewNineSliceColor(colornames.Beige)}),
+			widget.ButtonOpts.Text("Watch this run", font.TTF36, &widget.ButtonTextColor{Idle: color.Black}),
+			widget.ButtonOpts.TextPadding(widget.Insets{Top: 5, Bottom: 5, Left: 20, Right: 20}),
+			widget.ButtonOpts.ClickedHandler(func(args *widget.ButtonClickedEventArgs) {
+				m.Ended = true
+				m.NextReplayFile = r.Replay
+			}),
+		))
+	}
+
+	return table
+}
@@ -6,6 +6,7 @@ import (
 	"math"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/ebitenui/ebitenui"
 	"github.com/ebitenui/ebitenui/image"
@@ -13,7 +14,9 @@ import (
 	"github.com/hajimehoshi/ebiten/v2"
 	"golang.org/x/image/colornames"
 
+	"github.com/gopher-co/td-game/models/config"
 	"github.com/gopher-co/td-game/models/general"
+	"github.com/gopher-co/td-game/models/ingame"
 	"github.com/gopher-co/td-game/ui"
 	"github.com/gopher-co/td-game/ui/font"
 )
@@ -83,7 +86,7 @@ func (m *MenuState) btn(widgets general.Widgets) *widget.Container {
 			}),
 			widget.GridLayoutOpts.Spacing(0, 50),
 			widget.GridLayoutOpts.Padding(widget.Insets{Top: 50}),
-			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, false, false, false, false}),
+			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, false, false, false, false, false, false}),
 		)),
 		widget.ContainerOpts.BackgroundImage(image.NewNineSliceSimple(widgets[ui.MenuLeftSidebarImage], 0, 1)),
 	)
@@ -138,14 +141,50 @@ func (m *MenuState) btn(widgets general.Widgets) *widget.Container {
 		}),
 	)
 
+	btn5 := widget.NewButton(
+		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.MinSize(600, 100)),
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle: image.NewNineSliceSimple(widgets[ui.MenuButtonReplaysImage], 0, 1),
+		}),
+		widget.ButtonOpts.Text("Achievements", font.TTF72, &widget.ButtonTextColor{Idle: color.White}),
+		widget.ButtonOpts.ClickedHandler(func(_ *widget.ButtonClickedEventArgs) {
+			m.UI = m.loadAchievementsMenuUI(widgets)
+		}),
+	)
+
 	buttons.AddChild(logoImage)
+	if m.Saved != nil {
+		buttons.AddChild(m.continueButton(widgets))
+	}
 	buttons.AddChild(btn1)
 	buttons.AddChild(btn2)
+	buttons.AddChild(btn5)
 	buttons.AddChild(btn3)
 	buttons.AddChild(btn4)
 	return buttons
 }
 
+// continueButton returns the button that continues the saved game.
+func (m *MenuState) continueButton(widgets general.Widgets) *widget.Button {
+	w := m.Saved.Watcher
+	label := fmt.Sprintf("Continue: %v, wave %d", w.Name, m.Saved.CurrentWave+1)
+	if m.Saved.CurrentWave < 0 {
+		label = fmt.Sprintf("Continue: %v", w.Name)
+	}
+
+	return widget.NewButton(
+		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.MinSize(600, 100)),
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle: image.NewNineSliceSimple(widgets[ui.MenuButtonPlayImage], 0, 1),
+		}),
+		widget.ButtonOpts.Text(label, font.TTF40, &widget.ButtonTextColor{Idle: color.White}),
+		widget.ButtonOpts.ClickedHandler(func(_ *widget.ButtonClickedEventArgs) {
+			m.Continue = true
+			m.Ended = true
+		}),
+	)
+}
+
 // loadLevelMenuUI loads the level menu UI.
 func (m *MenuState) loadLevelMenuUI(widgets general.Widgets) *ebitenui.UI {
 	bgImg := widgets[ui.MenuBackgroundImage]
@@ -154,7 +193,7 @@ func (m *MenuState) loadLevelMenuUI(widgets general.Widgets) *ebitenui.UI {
 	root := widget.NewContainer(
 		widget.ContainerOpts.Layout(widget.NewGridLayout(
 			widget.GridLayoutOpts.Columns(1),
-			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, true}),
+			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, false, true}),
 			widget.GridLayoutOpts.Spacing(0, 0),
 		)),
 		widget.ContainerOpts.BackgroundImage(menuBackground),
@@ -182,10 +221,10 @@ func (m *MenuState) loadLevelMenuUI(widgets general.Widgets) *ebitenui.UI {
 	)
 
 	text := ""
-	if len(m.State.LevelsComplete) == len(m.Levels) {
+	if len(m.PlayerState.LevelsComplete) == len(m.Levels) {
 		text = "YAYY! YOU'VE COMPLETED ALL THE LEVELS"
 	} else {
-		text = fmt.Sprintf("Completed %d/%d levels", len(m.State.LevelsComplete), len(m.Levels))
+		text = fmt.Sprintf("Completed %d/%d levels", len(m.PlayerState.LevelsComplete), len(m.Levels))
 	}
 
 	//defer font.TTF64.Close()
@@ -199,13 +238,114 @@ func (m *MenuState) loadLevelMenuUI(widgets general.Widgets) *ebitenui.UI {
 	infoContainer.AddChild(textCompleted)
 
 	root.AddChild(infoContainer)
+	root.AddChild(m.loadDifficulties(widgets))
 	root.AddChild(m.loadScrollingLevels(widgets))
 
 	return &ebitenui.UI{Container: root}
 }
 
+// loadDifficulties loads the radio group of the difficulties.
+func (m *MenuState) loadDifficulties(_ general.Widgets) *widget.Container {
+	root := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionHorizontal),
+			widget.RowLayoutOpts.Spacing(20),
+			widget.RowLayoutOpts.Padding(widget.Insets{Top: 10, Bottom: 10, Left: 20}),
+		)),
+	)
+
+	var active widget.RadioGroupElement
+	elements := make([]widget.RadioGroupElement, 0, len(m.Difficulties))
+	for _, d := range m.Difficulties {
+		btn := widget.NewButton(
+			widget.ButtonOpts.Image(&widget.ButtonImage{
+				Idle:    image.NewNineSliceColor(colornames.Dimgray),
+				Pressed: image.NewNineSliceColor(colornames.Darkorange),
+			}),
+			widget.ButtonOpts.Text(d.Name, font.TTF36, &widget.ButtonTextColor{Idle: color.White}),
+			widget.ButtonOpts.TextPadding(widget.Insets{Top: 5, Bottom: 5, Left: 20, Right: 20}),
+			widget.ButtonOpts.ClickedHandler(func(args *widget.ButtonClickedEventArgs) {
+				m.Difficulty = d.Name
+			}),
+		)
+		if d.Name == m.Difficulty {
+			active = btn
+		}
+
+		elements = append(elements, btn)
+		root.AddChild(btn)
+	}
+
+	if len(elements) > 0 {
+		widget.NewRadioGroup(
+			widget.RadioGroupOpts.Elements(elements...),
+			widget.RadioGroupOpts.InitialElement(active),
+		)
+	}
+
+	root.AddChild(m.loadNickname())
+
+	return root
+}
+
+// loadNickname loads the input of the nickname shown in the leaderboard.
+func (m *MenuState) loadNickname() *widget.TextInput {
+	input := widget.NewTextInput(
+		widget.TextInputOpts.Validation(func(newInputText string) (bool, *string) {
+			if valid(newInputText) && len(newInputText) < 20 {
+				return true, &newInputText
+			}
+			return false, nil
+		}),
+		widget.TextInputOpts.Face(font.TTF36),
+		widget.TextInputOpts.Color(&widget.TextInputColor{
+			Idle:          color.NRGBA{R: 254, G: 255, B: 255, A: 255},
+			Disabled:      color.NRGBA{R: 200, G: 200, B: 200, A: 255},
+			Caret:         color.NRGBA{R: 254, G: 255, B: 255, A: 255},
+			DisabledCaret: color.NRGBA{R: 200, G: 200, B: 200, A: 255},
+		}),
+		widget.TextInputOpts.Placeholder(ingame.DefaultNickname),
+		widget.TextInputOpts.Image(&widget.TextInputImage{
+			Idle:     image.NewNineSliceColor(color.NRGBA{R: 100, G: 100, B: 100, A: 255}),
+			Disabled: image.NewNineSliceColor(color.NRGBA{R: 100, G: 100, B: 100, A: 255}),
+		}),
+		widget.TextInputOpts.CaretOpts(
+			widget.CaretOpts.Size(font.TTF36, 2),
+		),
+		widget.TextInputOpts.ChangedHandler(func(args *widget.TextInputChangedEventArgs) {
+			m.PlayerState.Nickname = args.InputText
+		}),
+		widget.TextInputOpts.WidgetOpts(
+			widget.WidgetOpts.MinSize(300, 0),
+		),
+		widget.TextInputOpts.Padding(widget.Insets{Top: 5, Left: 10, Right: 10, Bottom: 5}),
+	)
+	input.SetText(m.PlayerState.Nickname)
+
+	return input
+}
+
+// completedText returns the stars, the best score, the best wave
+// and the difficulties the level is completed on.
+func (m *MenuState) completedText(level string) string {
+	done := make([]string, 0, len(m.Difficulties))
+	for _, d := range m.Difficulties {
+		if _, ok := m.PlayerState.LevelsComplete[level][d.Name]; ok {
+			done = append(done, d.Name)
+		}
+	}
+
+	text := fmt.Sprintf("Stars: %d/%d\nBest score: %d\nBest wave: %d",
+		m.PlayerState.Stars[level], config.MaxStars, m.PlayerState.BestScore[level], m.PlayerState.BestWave[level])
+	if len(done) > 0 {
+		text += "\nCompleted: " + strings.Join(done, ", ")
+	}
+
+	return text
+}
+
 // loadScrollingLevels loads the scrolling levels.
-func (m *MenuState) loadScrollingLevels(_ general.Widgets) *widget.Container {
+func (m *MenuState) loadScrollingLevels(widgets general.Widgets) *widget.Container {
 	root := widget.NewContainer(
 		widget.ContainerOpts.Layout(widget.NewGridLayout(
 			widget.GridLayoutOpts.Columns(1),
@@ -234,7 +374,8 @@ func (m *MenuState) loadScrollingLevels(_ general.Widgets) *widget.Container {
 			widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.MinSize(400, 900)),
 			widget.ContainerOpts.Layout(widget.NewGridLayout(
 				widget.GridLayoutOpts.Columns(1),
-				widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, true, false}),
+				widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, true, false, false, false, false}),
+				widget.GridLayoutOpts.Spacing(0, 10),
 			)),
 		)
 		text1 := widget.NewText(
@@ -257,9 +398,34 @@ func (m *MenuState) loadScrollingLevels(_ general.Widgets) *widget.Container {
 			}),
 		)
 
+		text3 := widget.NewText(
+			widget.TextOpts.Text(m.completedText(k), font.TTF36, color.White),
+			widget.TextOpts.Position(widget.TextPositionCenter, widget.TextPositionCenter),
+		)
+		btnEndless := widget.NewButton(
+			widget.ButtonOpts.Image(&widget.ButtonImage{Idle: image.NewNineSliceColor(colornames.Darkslateblue)}),
+			widget.ButtonOpts.Text("Endless", font.TTF64, &widget.ButtonTextColor{Idle: color.White}),
+			widget.ButtonOpts.ClickedHandler(func(args *widget.ButtonClickedEventArgs) {
+				m.Ended = true
+				m.Next = k
+				m.Endless = true
+			}),
+		)
+
 		cont.AddChild(text1)
 		cont.AddChild(text2)
+		cont.AddChild(text3)
+		btnTop := widget.NewButton(
+			widget.ButtonOpts.Image(&widget.ButtonImage{Idle: image.NewNineSliceColor(colornames.Darkgoldenrod)}),
+			widget.ButtonOpts.Text("Leaderboard", font.TTF64, &widget.ButtonTextColor{Idle: color.White}),
+			widget.ButtonOpts.ClickedHandler(func(args *widget.ButtonClickedEventArgs) {
+				m.UI = m.loadLeaderboardUI(widgets, k)
+			}),
+		)
+
 		cont.AddChild(btn)
+		cont.AddChild(btnEndless)
+		cont.AddChild(btnTop)
 
 		content.AddChild(cont)
 	}
@@ -6,18 +6,18 @@ import (
 
 	"github.com/gopher-co/td-game/models/config"
 	"github.com/gopher-co/td-game/models/coopstate"
+	"github.com/gopher-co/td-game/models/gamecontext"
 	"github.com/gopher-co/td-game/models/general"
 	"github.com/gopher-co/td-game/models/ingame"
-	"github.com/gopher-co/td-game/replay"
+	"github.com/gopher-co/td-game/models/scene"
 )
 
 // MenuState is a struct that represents the state of the menu.
+//
+// The menu is the root scene: it starts the games and the replays
+// and updates the data of the player in the context by their results.
 type MenuState struct {
-	// Replays is a list of replays.
-	Replays []*replay.Watcher
-
-	// Levels is a list of levels.
-	Levels map[string]*config.Level
+	*gamecontext.GameContext
 
 	// Ended is true if the menu is ended.
 	Ended bool
@@ -28,31 +28,43 @@ type MenuState struct {
 	// Next is a name of the next level.
 	Next string
 
+	// Endless is a flag that shows if the next level is played in the endless mode.
+	Endless bool
+
+	// Difficulty is a name of the difficulty the next level is played on.
+	Difficulty string
+
 	// NextReplay is an index of the next replay.
 	NextReplay int
 
-	// State is a state of the player.
-	State *ingame.PlayerState
+	// NextReplayFile is a path to the file of the next replay.
+	// It is set when a run is chosen from the leaderboard.
+	NextReplayFile string
+
+	// Continue is a flag that shows if the saved game is continued.
+	Continue bool
 
 	// Host is a host of the game.
 	Host coopstate.GameHostClient
 
 	// Stream is a stream of the game.
 	Stream coopstate.GameHost_JoinLobbyClient
+
+	// Seed is a seed of the co-op game shared by the host.
+	Seed uint64
+
+	// achiever evaluates the achievements of the current game.
+	achiever *ingame.Achiever
 }
 
-// New creates a new entity of MenuState.
-func New(state *ingame.PlayerState, configs map[string]*config.Level, replays []*replay.Watcher, widgets general.Widgets) *MenuState {
+// New creates a new entity of MenuState with the context of the game.
+func New(ctx *gamecontext.GameContext) *MenuState {
 	ms := &MenuState{
-		Levels:     configs,
-		Ended:      false,
-		UI:         nil,
-		Next:       "",
-		Replays:    replays,
-		NextReplay: -1,
-		State:      state,
+		GameContext: ctx,
+		Difficulty:  config.DefaultDifficultyName,
+		NextReplay:  -1,
 	}
-	ms.loadUI(widgets)
+	ms.loadUI(ctx.Widgets)
 
 	return ms
 }
@@ -63,14 +75,14 @@ func (m *MenuState) Draw(image *ebiten.Image) {
 }
 
 // Update updates the menu.
-func (m *MenuState) Update() error {
+// The scene chosen in the menu is started when the menu is ended.
+func (m *MenuState) Update(sm *scene.Manager) error {
 	m.UI.Update()
-	return nil
-}
+	if m.Ended {
+		m.start(sm)
+	}
 
-// End returns true if the menu is ended.
-func (m *MenuState) End() bool {
-	return m.Ended
+	return nil
 }
 
 // loadUI loads the UI.
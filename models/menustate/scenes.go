@@ -0,0 +1,172 @@
+package menustate
+
+import (
+	"log"
+	"time"
+
+	"github.com/gopher-co/td-game/io"
+	"github.com/gopher-co/td-game/models/controller"
+	"github.com/gopher-co/td-game/models/coopstate"
+	"github.com/gopher-co/td-game/models/dialogstate"
+	"github.com/gopher-co/td-game/models/gamestate"
+	"github.com/gopher-co/td-game/models/ingame"
+	"github.com/gopher-co/td-game/models/replaystate"
+	"github.com/gopher-co/td-game/models/scene"
+	"github.com/gopher-co/td-game/replay"
+)
+
+// start starts the scene chosen in the menu.
+// The menu is shown again when the scene is popped.
+func (m *MenuState) start(sm *scene.Manager) {
+	defer m.clearChoice()
+
+	switch {
+	case m.Stream != nil:
+		log.Println("Starting stream")
+		gs := coopstate.New(m.GameContext, m.Levels[m.Next], m.Seed, m.Host, m.Stream)
+		scene.Call(sm, gs, func(r gamestate.Result) { m.finishGame(sm, r) })
+	case m.Continue:
+		m.resume(sm)
+	case m.Next != "":
+		gs := gamestate.New(m.GameContext, m.Levels[m.Next], m.DifficultyByName(m.Difficulty), controller.NewLocal())
+		gs.Seed(uint64(time.Now().UnixNano()))
+		if m.Endless {
+			if err := gs.EnableEndless(); err != nil {
+				log.Println("couldn't start the endless game:", err)
+				m.loadUI(m.Widgets)
+				return
+			}
+		}
+		m.achiever = ingame.NewAchiever(m.Achievements, m.AchievementProgress, m.Next)
+		gs.Map.Subscribe(m.achiever.Handle)
+		scene.Call(sm, gs, func(r gamestate.Result) { m.finishGame(sm, r) })
+	case m.NextReplayFile != "":
+		r, err := replay.Load(m.NextReplayFile)
+		if err != nil {
+			log.Println("couldn't load replay:", err)
+			m.loadUI(m.Widgets)
+			return
+		}
+		m.watch(sm, r)
+	case m.NextReplay != -1:
+		m.watch(sm, m.Replays[m.NextReplay])
+	}
+}
+
+// clearChoice clears the choice of the next scene.
+// The difficulty is kept for the next game.
+func (m *MenuState) clearChoice() {
+	m.Ended = false
+	m.Next = ""
+	m.Endless = false
+	m.NextReplay = -1
+	m.NextReplayFile = ""
+	m.Continue = false
+	m.Host = nil
+	m.Stream = nil
+	m.Seed = 0
+}
+
+// watch starts the replay r.
+func (m *MenuState) watch(sm *scene.Manager, r *replay.Watcher) {
+	rs, err := replaystate.New(m.GameContext, r)
+	if err != nil {
+		log.Println("couldn't watch replay:", err)
+		m.loadUI(m.Widgets)
+		return
+	}
+
+	scene.Call(sm, rs, func(any) {
+		m.loadUI(m.Widgets)
+	})
+}
+
+// resume continues the saved game.
+// If the game can't be continued, the save is removed.
+func (m *MenuState) resume(sm *scene.Manager) {
+	w := m.Saved.Watcher
+	level, ok := m.Levels[w.Name]
+	if !ok {
+		log.Println("couldn't continue the game: level", w.Name, "doesn't exist")
+		m.dropSave()
+		return
+	}
+
+	gs := gamestate.New(m.GameContext, level, m.DifficultyByName(w.Difficulty), controller.NewLocal())
+	gs.Seed(w.Seed)
+	if w.Endless {
+		if err := gs.EnableEndless(); err != nil {
+			log.Println("couldn't continue the game:", err)
+			m.dropSave()
+			return
+		}
+	}
+
+	if err := gs.Restore(m.Saved); err != nil {
+		log.Println("couldn't restore the game:", err)
+		m.dropSave()
+		return
+	}
+
+	m.achiever = ingame.NewAchiever(m.Achievements, m.AchievementProgress, w.Name)
+	m.achiever.Resume(gs.Map.Towers, gs.PlayerMapState.Health < w.InitPlayerMapState.Health)
+	gs.Map.Subscribe(m.achiever.Handle)
+
+	gs.Saved = m.Saved
+	scene.Call(sm, gs, func(r gamestate.Result) { m.finishGame(sm, r) })
+}
+
+// dropSave removes the saved game.
+func (m *MenuState) dropSave() {
+	m.Saved = nil
+	if err := io.RemoveSave(); err != nil {
+		log.Println(err)
+	}
+
+	m.loadUI(m.Widgets)
+}
+
+// finishGame updates the stats of the player, the leaderboard and the achievements
+// by the result of the game. The co-op games don't count in the player's stats.
+// The achievements unlocked in the game are shown to the player over the menu.
+func (m *MenuState) finishGame(sm *scene.Manager, r gamestate.Result) {
+	defer m.loadUI(m.Widgets)
+
+	m.Replays = append(m.Replays, r.Watcher)
+	if r.Coop {
+		return
+	}
+	m.Saved = r.Saved
+
+	changed := m.PlayerState.UpdateBestWave(r.LevelName, r.Wave)
+	if r.Win {
+		m.PlayerState.CompleteLevel(r.LevelName, r.Difficulty)
+		m.PlayerState.UpdateScore(r.LevelName, r.Score, r.Stars)
+		changed = true
+
+		record := ingame.Record{
+			Nickname: m.PlayerState.Nick(),
+			Score:    r.Score,
+			Date:     r.Watcher.Time,
+			Replay:   r.ReplayPath,
+		}
+		// saved synchronously: the menu changes the leaderboard and the stats on the main loop
+		if m.Leaderboard.Add(r.LevelName, r.Difficulty, record) != -1 {
+			if err := io.SaveLeaderboard(m.Leaderboard); err != nil {
+				log.Println("leaderboard save unsuccessful:", err)
+			}
+		}
+	}
+	if changed {
+		if err := io.SaveStats(m.PlayerState); err != nil {
+			log.Println("save unsuccessful")
+		}
+	}
+
+	if unlocked := m.achiever.Finish(r.Win); len(unlocked) > 0 {
+		scene.Show(sm, dialogstate.NewNotice("Achievement unlocked!", unlocked), func(any) {})
+	}
+	if err := io.SaveAchievements(m.AchievementProgress); err != nil {
+		log.Println("achievements save unsuccessful:", err)
+	}
+}
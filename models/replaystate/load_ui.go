@@ -7,7 +7,6 @@ import (
 	"github.com/ebitenui/ebitenui"
 	image2 "github.com/ebitenui/ebitenui/image"
 	"github.com/ebitenui/ebitenui/widget"
-	"github.com/hajimehoshi/ebiten/v2"
 	"golang.org/x/image/colornames"
 
 	"github.com/gopher-co/td-game/models/general"
@@ -63,11 +62,7 @@ func (r *ReplayState) loadMapContainer(_ general.Widgets) *widget.Container {
 	)
 
 	r.uiUpdater.Append(func() {
-		if r.CurrentWave < 0 || r.CurrentWave >= len(r.GameRule) {
-			waveText.Label = ""
-			return
-		}
-		waveText.Label = fmt.Sprintf("Wave: %d/%d", r.CurrentWave+1, len(r.GameRule))
+		waveText.Label = r.WaveLabel()
 	})
 
 	waveContainer.AddChild(waveText)
@@ -84,8 +79,7 @@ func (r *ReplayState) loadMapContainer(_ general.Widgets) *widget.Container {
 		}),
 		widget.ButtonOpts.Text("Menu", font.TTF64, &widget.ButtonTextColor{Idle: color.White}),
 		widget.ButtonOpts.ClickedHandler(func(_ *widget.ButtonClickedEventArgs) {
-			r.setStateAfterEnd()
-			r.Ended = true
+			r.Stop()
 		}),
 		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
 			HorizontalPosition: widget.AnchorLayoutPositionEnd,
@@ -96,27 +90,13 @@ func (r *ReplayState) loadMapContainer(_ general.Widgets) *widget.Container {
 	)
 	buttonContainer.AddChild(backButton)
 
-	var speedButton *widget.Button
-	speedButton = widget.NewButton(
+	speedButton := widget.NewButton(
 		widget.ButtonOpts.Image(&widget.ButtonImage{
 			Idle: image2.NewNineSliceColor(colornames.Cornflowerblue),
 		}),
-		widget.ButtonOpts.Text(">>", font.TTF64, &widget.ButtonTextColor{Idle: color.White}),
+		widget.ButtonOpts.Text(r.Clock.String(), font.TTF64, &widget.ButtonTextColor{Idle: color.White}),
 		widget.ButtonOpts.ClickedHandler(func(_ *widget.ButtonClickedEventArgs) {
-			if r.speedUp {
-				ebiten.SetTPS(60)
-				r.speedUp = false
-				speedButton.Image = &widget.ButtonImage{
-					Idle: image2.NewNineSliceColor(colornames.Cornflowerblue),
-				}
-				return
-			}
-
-			ebiten.SetTPS(180)
-			r.speedUp = true
-			speedButton.Image = &widget.ButtonImage{
-				Idle: image2.NewNineSliceColor(colornames.Greenyellow),
-			}
+			r.Input.SetSpeed(r.Controller, r.Clock.NextScale())
 		}),
 		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
 			HorizontalPosition: widget.AnchorLayoutPositionEnd,
@@ -127,6 +107,17 @@ func (r *ReplayState) loadMapContainer(_ general.Widgets) *widget.Container {
 	)
 	speedContainer.AddChild(speedButton)
 
+	r.uiUpdater.Append(func() {
+		if label := r.Clock.String(); speedButton.Text().Label != label {
+			speedButton.Text().Label = label
+			clr := colornames.Cornflowerblue
+			if r.Clock.Scale > 1 {
+				clr = colornames.Greenyellow
+			}
+			speedButton.Image = &widget.ButtonImage{Idle: image2.NewNineSliceColor(clr)}
+		}
+	})
+
 	mapContainer.AddChild(waveContainer)
 	mapContainer.AddChild(buttonContainer)
 	mapContainer.AddChild(speedContainer)
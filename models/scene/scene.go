@@ -0,0 +1,126 @@
+// Package scene provides the stack of the scenes of the game.
+//
+// A scene is a screen of the game: the menu, a game, a replay or an overlay
+// over them like a pause menu or a confirmation dialog. The scenes start the
+// next ones themselves by pushing them on the stack and pass their results
+// back to the scenes that pushed them when they are popped.
+package scene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/gopher-co/td-game/models/general"
+)
+
+// Scene is a screen of the game.
+type Scene interface {
+	// Drawable draws the scene.
+	general.Drawable[*ebiten.Image]
+
+	// Update updates the scene by one frame.
+	// Only the top scene of the stack is updated; it changes the stack through m.
+	Update(m *Manager) error
+}
+
+// entry is a scene on the stack.
+type entry struct {
+	// scene is the scene.
+	scene Scene
+
+	// overlay is a flag that shows if the scene below is drawn under the scene.
+	overlay bool
+
+	// done is called with the result of the scene when it is popped.
+	// It is nil if nobody waits for the result.
+	done func(result any)
+}
+
+// Manager is a stack of the scenes.
+// The top scene is updated, the scenes are drawn from the bottom up
+// starting from the top one that isn't an overlay.
+type Manager struct {
+	// stack is a stack of the scenes, the top one is the last.
+	stack []entry
+}
+
+// New creates a new entity of Manager with the root scene.
+func New(root Scene) *Manager {
+	return &Manager{stack: []entry{{scene: root}}}
+}
+
+// Push puts the scene s on the top of the stack.
+// The scenes below it aren't drawn until it is popped.
+func (m *Manager) Push(s Scene) {
+	m.stack = append(m.stack, entry{scene: s})
+}
+
+// Replace replaces the top scene with s.
+// The scene waiting for the result of the top scene gets the result of s instead.
+func (m *Manager) Replace(s Scene) {
+	m.stack[len(m.stack)-1].scene = s
+}
+
+// Pop removes the top scene from the stack and passes the result
+// to the scene that waits for it. The root scene is never popped.
+func (m *Manager) Pop(result any) {
+	if len(m.stack) == 1 {
+		return
+	}
+
+	e := m.stack[len(m.stack)-1]
+	m.stack = m.stack[:len(m.stack)-1]
+	if e.done != nil {
+		e.done(result)
+	}
+}
+
+// Top returns the top scene.
+func (m *Manager) Top() Scene {
+	return m.stack[len(m.stack)-1].scene
+}
+
+// Len returns the number of the scenes on the stack.
+func (m *Manager) Len() int {
+	return len(m.stack)
+}
+
+// Update updates the top scene.
+func (m *Manager) Update() error {
+	return m.Top().Update(m)
+}
+
+// Draw draws the top scene and the scenes under the overlays.
+func (m *Manager) Draw(screen *ebiten.Image) {
+	bottom := len(m.stack) - 1
+	for bottom > 0 && m.stack[bottom].overlay {
+		bottom--
+	}
+
+	for _, e := range m.stack[bottom:] {
+		e.scene.Draw(screen)
+	}
+}
+
+// Call pushes the scene s on the stack, done is called with its result when it is popped.
+// The result of the other type than R is passed as the zero value of R.
+func Call[R any](m *Manager, s Scene, done func(R)) {
+	m.stack = append(m.stack, entry{scene: s, done: typed(done)})
+}
+
+// Show pushes the overlay s drawn over the top scene, done is called with its result when it is popped.
+// The scene under the overlay isn't updated until the overlay is popped.
+func Show[R any](m *Manager, s Scene, done func(R)) {
+	m.stack = append(m.stack, entry{scene: s, overlay: true, done: typed(done)})
+}
+
+// typed wraps the function taking the result of the type R.
+func typed[R any](done func(R)) func(any) {
+	if done == nil {
+		return nil
+	}
+
+	return func(result any) {
+		r, _ := result.(R)
+		done(r)
+	}
+}
@@ -0,0 +1,94 @@
+package scene_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/gopher-co/td-game/models/scene"
+)
+
+// fake is a scene that records its updates and draws.
+type fake struct {
+	name   string
+	log    *[]string
+	update func(m *scene.Manager)
+}
+
+func (f *fake) Update(m *scene.Manager) error {
+	*f.log = append(*f.log, "update "+f.name)
+	if f.update != nil {
+		f.update(m)
+	}
+
+	return nil
+}
+
+func (f *fake) Draw(_ *ebiten.Image) {
+	*f.log = append(*f.log, "draw "+f.name)
+}
+
+func TestManager(t *testing.T) {
+	var log []string
+	root := &fake{name: "root", log: &log}
+	game := &fake{name: "game", log: &log}
+	dialog := &fake{name: "dialog", log: &log}
+
+	m := scene.New(root)
+	var won bool
+	scene.Call(m, game, func(r bool) { won = r })
+	scene.Show(m, dialog, func(r string) {
+		if r != "" {
+			t.Errorf("result of the other type = %q, want zero value", r)
+		}
+	})
+
+	// only the top scene is updated, the overlay is drawn over the game but not over the root
+	if err := m.Update(); err != nil {
+		t.Fatal(err)
+	}
+	m.Draw(nil)
+	want := []string{"update dialog", "draw game", "draw dialog"}
+	if len(log) != len(want) {
+		t.Fatalf("log = %v, want %v", log, want)
+	}
+	for i := range want {
+		if log[i] != want[i] {
+			t.Fatalf("log = %v, want %v", log, want)
+		}
+	}
+
+	m.Pop(42)
+	if m.Top() != game {
+		t.Fatalf("top = %v, want game", m.Top())
+	}
+
+	m.Pop(true)
+	if !won || m.Top() != root {
+		t.Errorf("won = %v, top = %v", won, m.Top())
+	}
+
+	// the root is never popped
+	m.Pop(nil)
+	if m.Len() != 1 {
+		t.Errorf("len = %d, want 1", m.Len())
+	}
+}
+
+func TestReplace(t *testing.T) {
+	var log []string
+	var result int
+	m := scene.New(&fake{name: "root", log: &log})
+	next := &fake{name: "next", log: &log, update: func(m *scene.Manager) { m.Pop(2) }}
+	scene.Call(m, &fake{name: "first", log: &log, update: func(m *scene.Manager) { m.Replace(next) }}, func(r int) { result = r })
+
+	for range 2 {
+		if err := m.Update(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if result != 2 || m.Len() != 1 {
+		t.Errorf("result = %d, len = %d", result, m.Len())
+	}
+}
@@ -26,3 +26,23 @@ func Save(filename string, w *Watcher) error {
 
 	return nil
 }
+
+// Load loads the watcher from the file.
+// It returns an error if something went wrong.
+func Load(filename string) (*Watcher, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	w := new(Watcher)
+	if err := w.Read(bufio.NewReader(f)); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
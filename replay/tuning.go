@@ -0,0 +1,11 @@
+package replay
+
+import "github.com/gopher-co/td-game/models/ingame"
+
+// legacyAims contains the aims of the tuning actions of the old replays.
+// The old replays record the aim in the type of the action instead of its info.
+var legacyAims = map[ActionType]ingame.Aim{
+	TuneFirst:  ingame.First,
+	TuneStrong: ingame.Strongest,
+	TuneWeak:   ingame.Weakest,
+}
@@ -3,8 +3,9 @@ package replay
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
-	"strconv"
 
 	"github.com/gopher-co/td-game/models/general"
 	"github.com/gopher-co/td-game/models/ingame"
@@ -30,16 +31,41 @@ const (
 	TurnOn
 
 	// TuneFirst is a type of action that represents tuning first.
+	//
+	// Deprecated: it is only read from the old replays, Tune is recorded instead.
 	TuneFirst
 
 	// TuneStrong is a type of action that represents tuning strong.
+	//
+	// Deprecated: it is only read from the old replays, Tune is recorded instead.
 	TuneStrong
 
 	// TuneWeak is a type of action that represents tuning weak.
+	//
+	// Deprecated: it is only read from the old replays, Tune is recorded instead.
 	TuneWeak
 
 	// Stop is a type of action that represents stopping the game.
 	Stop
+
+	// UseTowerAbility is a type of action that represents using the ability of a tower.
+	UseTowerAbility
+
+	// UseAbility is a type of action that represents using the player's ability.
+	UseAbility
+
+	// StartWave is a type of action that represents calling the next wave.
+	StartWave
+
+	// Undo is a type of action that represents undoing the last action of the build phase.
+	Undo
+
+	// Tune is a type of action that represents tuning the tower to the aim.
+	Tune
+
+	// EndWave is a type of action that represents the end of the wave.
+	// It is recorded by the game, the actions after it are made in the build phase.
+	EndWave
 )
 
 // Action is an entity that represents an action.
@@ -55,90 +81,80 @@ type Action struct {
 }
 
 // UnmarshalJSON unmarshals the action.
+// The action must have a frame, a known type and the info of its type without unknown fields.
 func (a *Action) UnmarshalJSON(b []byte) error {
-	indexFStart := 5
-	indexFEnd := bytes.Index(b, []byte{','})
-	f, err := strconv.Atoi(string(b[indexFStart:indexFEnd]))
-	if err != nil {
-		return err
+	var raw struct {
+		F    *general.Frames `json:"f"`
+		Type *ActionType     `json:"type"`
+		Info json.RawMessage `json:"info"`
 	}
-
-	a.F = f
-
-	indexTypeStart := bytes.Index(b, []byte(`"type":`)) + 7
-	indexTypeEnd := bytes.Index(b[indexTypeStart:], []byte{','}) + indexTypeStart
-	actionType, err := strconv.Atoi(string(b[indexTypeStart:indexTypeEnd]))
-	if err != nil {
-		return err
+	if err := decodeStrict(b, &raw); err != nil {
+		return fmt.Errorf("invalid action: %w", err)
+	}
+	if raw.F == nil || raw.Type == nil || len(raw.Info) == 0 || bytes.Equal(raw.Info, []byte("null")) {
+		return errors.New("invalid action: the frame, the type and the info are required")
 	}
 
-	a.Type = ActionType(actionType)
-
-	i := bytes.Index(b, []byte(`"info":`)) + 7
-	infob := b[i : len(b)-1]
-
-	switch ActionType(actionType) {
+	var err error
+	switch *raw.Type {
 	case PutTower:
-		info := InfoPutTower{}
-		if err := json.Unmarshal(infob, &info); err != nil {
-			return err
-		}
-		a.Info = info
+		a.Info, err = decodeInfo[InfoPutTower](raw.Info)
 	case SellTower:
-		info := InfoSellTower{}
-		if err := json.Unmarshal(infob, &info); err != nil {
-			return err
-		}
-		a.Info = info
+		a.Info, err = decodeInfo[InfoSellTower](raw.Info)
 	case UpgradeTower:
-		info := InfoUpgradeTower{}
-		if err := json.Unmarshal(infob, &info); err != nil {
-			return err
-		}
-		a.Info = info
+		a.Info, err = decodeInfo[InfoUpgradeTower](raw.Info)
 	case TurnOff:
-		info := InfoTurnOffTower{}
-		if err := json.Unmarshal(infob, &info); err != nil {
-			return err
-		}
-		a.Info = info
+		a.Info, err = decodeInfo[InfoTurnOffTower](raw.Info)
 	case TurnOn:
-		info := InfoTurnOnTower{}
-		if err := json.Unmarshal(infob, &info); err != nil {
-			return err
-		}
-		a.Info = info
-	case TuneFirst:
-		info := InfoTuneFirst{}
-		if err := json.Unmarshal(infob, &info); err != nil {
-			return err
-		}
-		a.Info = info
-	case TuneStrong:
-		info := InfoTuneStrong{}
-		if err := json.Unmarshal(infob, &info); err != nil {
-			return err
-		}
-		a.Info = info
-	case TuneWeak:
-		info := InfoTuneWeak{}
-		if err := json.Unmarshal(infob, &info); err != nil {
-			return err
-		}
-		a.Info = info
+		a.Info, err = decodeInfo[InfoTurnOnTower](raw.Info)
 	case Stop:
-		info := InfoStop{}
-		if err := json.Unmarshal(infob, &info); err != nil {
-			return err
-		}
+		a.Info, err = decodeInfo[InfoStop](raw.Info)
+	case Tune:
+		a.Info, err = decodeInfo[InfoTune](raw.Info)
+	case TuneFirst, TuneStrong, TuneWeak:
+		var info InfoTune
+		info, err = decodeInfo[InfoTune](raw.Info)
+		info.Aim = legacyAims[*raw.Type]
 		a.Info = info
+	case UseTowerAbility:
+		a.Info, err = decodeInfo[InfoUseTowerAbility](raw.Info)
+	case UseAbility:
+		a.Info, err = decodeInfo[InfoUseAbility](raw.Info)
+	case StartWave:
+		a.Info, err = decodeInfo[InfoStartWave](raw.Info)
+	case Undo:
+		a.Info, err = decodeInfo[InfoUndo](raw.Info)
+	case EndWave:
+		a.Info, err = decodeInfo[InfoEndWave](raw.Info)
 	default:
-		return err
+		return fmt.Errorf("invalid action: unknown type %d", *raw.Type)
 	}
+	if err != nil {
+		return fmt.Errorf("invalid info of action type %d: %w", *raw.Type, err)
+	}
+
+	a.F = *raw.F
+	a.Type = *raw.Type
 
 	return nil
 }
 
+// decodeInfo decodes the info of the action.
+func decodeInfo[T any](b []byte) (T, error) {
+	var info T
+	err := decodeStrict(b, &info)
+
+	return info, err
+}
+
+// decodeStrict decodes the JSON value to v and rejects the fields v doesn't have.
+func decodeStrict(b []byte, v any) error {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.DisallowUnknownFields()
+
+	return d.Decode(v)
+}
+
 // InfoPutTower is an info of the action that represents putting a tower.
 type InfoPutTower struct {
 	// Name is a name of the tower.
@@ -161,6 +177,10 @@ type InfoSellTower struct {
 type InfoUpgradeTower struct {
 	// Index is an index of the tower.
 	Index int `json:"index"`
+
+	// Upgrade is an ID of the chosen upgrade.
+	// Empty Upgrade means the first available one (replays of linear upgrades).
+	Upgrade string `json:"upgrade,omitempty"`
 }
 
 // InfoTurnOffTower is an info of the action that represents turning off a tower.
@@ -175,22 +195,49 @@ type InfoTurnOnTower struct {
 	Index int `json:"index"`
 }
 
-// InfoTuneFirst is an info of the action that represents tuning first.
-type InfoTuneFirst struct {
+// InfoTune is an info of the action that represents tuning the tower to the aim.
+type InfoTune struct {
 	// Index is an index of the tower.
 	Index int `json:"index"`
+
+	// Aim is a new aim of the tower.
+	Aim ingame.Aim `json:"aim"`
 }
 
-// InfoTuneStrong is an info of the action that represents tuning strong.
-type InfoTuneStrong struct {
+// InfoUseTowerAbility is an info of the action that represents using the ability of a tower.
+type InfoUseTowerAbility struct {
 	// Index is an index of the tower.
 	Index int `json:"index"`
 }
 
-// InfoTuneWeak is an info of the action that represents tuning weak.
-type InfoTuneWeak struct {
-	// Index is an index of the tower.
-	Index int `json:"index"`
+// InfoUseAbility is an info of the action that represents using the player's ability.
+type InfoUseAbility struct {
+	// Name is a name of the ability.
+	Name string `json:"name"`
+
+	// X is a x coordinate of the target.
+	X int `json:"x"`
+
+	// Y is a y coordinate of the target.
+	Y int `json:"y"`
+}
+
+// InfoStartWave is an info of the action that represents calling the next wave.
+type InfoStartWave struct {
+	// Wave is a number of the called wave.
+	Wave int `json:"wave"`
+}
+
+// InfoUndo is an info of the action that represents undoing the last action of the build phase.
+type InfoUndo struct {
+	// Depth is a number of the actions that could be undone before this one.
+	Depth int `json:"depth"`
+}
+
+// InfoEndWave is an info of the action that represents the end of the wave.
+type InfoEndWave struct {
+	// Wave is a number of the ended wave.
+	Wave int `json:"wave"`
 }
 
 // InfoStop is an info of the action that represents stopping the game.
@@ -210,6 +257,16 @@ type Watcher struct {
 	// InitPlayerMapState is an initial player map state.
 	InitPlayerMapState ingame.PlayerMapState `json:"init_player_map_state"`
 
+	// Difficulty is a name of the difficulty the game was played on.
+	// If it's empty, config.DefaultDifficultyName is used.
+	Difficulty string `json:"difficulty,omitempty"`
+
+	// Endless is a flag that shows if the game was played in the endless mode.
+	Endless bool `json:"endless,omitempty"`
+
+	// Seed is a seed of the random numbers of the game and of its endless waves.
+	Seed uint64 `json:"seed,omitempty"`
+
 	// Actions is a list of actions.
 	Actions []Action `json:"actions"`
 }
@@ -2,9 +2,13 @@ package replay_test
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/gopher-co/td-game/models/ingame"
 	"github.com/gopher-co/td-game/replay"
 )
 
@@ -28,8 +32,28 @@ func TestActions(t *testing.T) {
 		},
 		{
 			F:    27,
-			Type: replay.TuneWeak,
-			Info: replay.InfoTuneWeak{Index: 0},
+			Type: replay.Tune,
+			Info: replay.InfoTune{Index: 0, Aim: ingame.Weakest},
+		},
+		{
+			F:    30,
+			Type: replay.Tune,
+			Info: replay.InfoTune{Index: 1, Aim: ingame.Vulnerable},
+		},
+		{
+			F:    31,
+			Type: replay.UseAbility,
+			Info: replay.InfoUseAbility{Name: "airstrike", X: 100, Y: 200},
+		},
+		{
+			F:    40,
+			Type: replay.StartWave,
+			Info: replay.InfoStartWave{Wave: 1},
+		},
+		{
+			F:    41,
+			Type: replay.Undo,
+			Info: replay.InfoUndo{Depth: 2},
 		},
 	}}
 
@@ -47,3 +71,60 @@ func TestActions(t *testing.T) {
 		t.Errorf("got %#+v, expected %#+v", actualRep, rep)
 	}
 }
+
+func TestLegacyTuning(t *testing.T) {
+	tests := []struct {
+		at  replay.ActionType
+		aim ingame.Aim
+	}{
+		{replay.TuneFirst, ingame.First},
+		{replay.TuneStrong, ingame.Strongest},
+		{replay.TuneWeak, ingame.Weakest},
+	}
+
+	for _, tt := range tests {
+		b := fmt.Sprintf(`{"name":"","time":"","init_player_map_state":{},"actions":[{"f":5,"type":%d,"info":{"index":3}}]}`, tt.at)
+		var w replay.Watcher
+		if err := w.Read(strings.NewReader(b)); err != nil {
+			t.Fatal(err)
+		}
+
+		want := replay.InfoTune{Index: 3, Aim: tt.aim}
+		if got := w.Actions[0].Info; got != want {
+			t.Errorf("type %d: info = %#v, want %#v", tt.at, got, want)
+		}
+	}
+}
+
+func TestMalformedActions(t *testing.T) {
+	tests := []string{
+		`{}`,
+		`[]`,
+		`null`,
+		`{"type":0,"info":{"name":"Gun","x":1,"y":2}}`,
+		`{"f":5,"info":{"name":"Gun","x":1,"y":2}}`,
+		`{"f":5,"type":0}`,
+		`{"f":5,"type":0,"info":null}`,
+		`{"f":5,"type":99,"info":{}}`,
+		`{"f":5,"type":0,"info":{"name":"Gun","z":1}}`,
+		`{"f":5,"type":0,"info":{"name":5}}`,
+		`{"f":5,"type":0,"info":{},"extra":1}`,
+		`{"f":"5","type":0,"info":{}}`,
+	}
+
+	for _, b := range tests {
+		var a replay.Action
+		if err := json.Unmarshal([]byte(b), &a); err == nil {
+			t.Errorf("%s: action %+v is decoded", b, a)
+		}
+	}
+
+	var a replay.Action
+	if err := json.Unmarshal([]byte(`{"info":{"y":2,"x":1,"name":"Gun"},"type":0,"f":5}`), &a); err != nil {
+		t.Fatal(err)
+	}
+	want := replay.Action{F: 5, Type: replay.PutTower, Info: replay.InfoPutTower{Name: "Gun", X: 1, Y: 2}}
+	if !reflect.DeepEqual(a, want) {
+		t.Errorf("reordered action = %+v, want %+v", a, want)
+	}
+}